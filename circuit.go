@@ -0,0 +1,258 @@
+package relaywarden
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+// CircuitState is the state of a circuit breaker for an endpoint group.
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through and counts their failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails requests immediately with a CircuitOpenError.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of trial requests through to
+	// decide whether to close the circuit again.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerOptions configures a CircuitBreaker. Zero values use the
+// defaults given for each field.
+type CircuitBreakerOptions struct {
+	// FailureRatio is the ratio of failed attempts within Window that opens
+	// the circuit. Defaults to 0.5.
+	FailureRatio float64
+	// MinRequests is the number of attempts within Window required before
+	// FailureRatio is evaluated. Defaults to 10.
+	MinRequests int
+	// Window is the period over which attempts are counted while the circuit
+	// is closed. Defaults to 1 minute.
+	Window time.Duration
+	// CoolDown is how long the circuit stays open before letting trial
+	// requests through. Defaults to 30 seconds.
+	CoolDown time.Duration
+	// HalfOpenRequests is the number of trial requests allowed while half
+	// open. The circuit closes once they all succeed. Defaults to 1.
+	HalfOpenRequests int
+	// Group maps a call to its endpoint group. Every group has its own
+	// circuit. Defaults to DefaultCircuitGroup.
+	Group func(call *CallInfo) string
+	// OnStateChange, if set, is called whenever the circuit of a group
+	// changes state, for example to raise an alert.
+	OnStateChange func(group string, from, to CircuitState)
+}
+
+// DefaultCircuitGroup puts message sending endpoints in the "messages" group
+// and every other endpoint in the "management" group.
+func DefaultCircuitGroup(call *CallInfo) string {
+	if call.Path == "/messages" || strings.HasPrefix(call.Path, "/messages/") {
+		return "messages"
+	}
+	return "management"
+}
+
+// CircuitBreaker stops sending requests to an endpoint group while the API is
+// failing, so that callers fail fast with a CircuitOpenError instead of piling
+// up retries. Transport errors and 5xx responses count as failures.
+//
+// A CircuitBreaker is safe for concurrent use and is shared by all resources
+// and scoped clients of the client it is configured on.
+type CircuitBreaker struct {
+	opts     CircuitBreakerOptions
+	mu       sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+// circuit is the state of a single endpoint group.
+type circuit struct {
+	state       CircuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openUntil   time.Time
+	trials      int
+	successes   int
+}
+
+// circuitOutcome is the result of an attempt as seen by the breaker.
+type circuitOutcome int
+
+const (
+	circuitSuccess circuitOutcome = iota
+	circuitFailure
+	// circuitIgnored releases a trial slot without counting the attempt,
+	// for example when the caller canceled the request.
+	circuitIgnored
+)
+
+// attemptOutcome classifies an attempt for the breaker. Transport errors and
+// 5xx responses are failures; attempts aborted by ctx are ignored.
+func attemptOutcome(ctx context.Context, resp *http.Response, err error) circuitOutcome {
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return circuitIgnored
+	case err != nil, resp.StatusCode >= 500:
+		return circuitFailure
+	default:
+		return circuitSuccess
+	}
+}
+
+// NewCircuitBreaker creates a CircuitBreaker.
+func NewCircuitBreaker(opts CircuitBreakerOptions) *CircuitBreaker {
+	if opts.FailureRatio <= 0 {
+		opts.FailureRatio = 0.5
+	}
+	if opts.MinRequests <= 0 {
+		opts.MinRequests = 10
+	}
+	if opts.Window <= 0 {
+		opts.Window = time.Minute
+	}
+	if opts.CoolDown <= 0 {
+		opts.CoolDown = 30 * time.Second
+	}
+	if opts.HalfOpenRequests <= 0 {
+		opts.HalfOpenRequests = 1
+	}
+	if opts.Group == nil {
+		opts.Group = DefaultCircuitGroup
+	}
+	return &CircuitBreaker{
+		opts:     opts,
+		circuits: make(map[string]*circuit),
+		now:      time.Now,
+	}
+}
+
+// State returns the current state of the circuit for group.
+func (b *CircuitBreaker) State(group string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[group]
+	if !ok {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && !b.now().Before(c.openUntil) {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// allow reports whether an attempt in group may be sent. It returns a
+// *errors.CircuitOpenError if the circuit is open or out of trial slots.
+func (b *CircuitBreaker) allow(group string) error {
+	b.mu.Lock()
+	c := b.circuit(group)
+	from := c.state
+	now := b.now()
+
+	if c.state == CircuitOpen && !now.Before(c.openUntil) {
+		c.state, c.trials, c.successes = CircuitHalfOpen, 0, 0
+	}
+
+	var err error
+	switch c.state {
+	case CircuitOpen:
+		err = &errors.CircuitOpenError{Group: group, Until: c.openUntil}
+	case CircuitHalfOpen:
+		if c.trials >= b.opts.HalfOpenRequests {
+			err = &errors.CircuitOpenError{Group: group, Until: now}
+		} else {
+			c.trials++
+		}
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	b.notify(group, from, to)
+	return err
+}
+
+// record counts the outcome of an attempt allowed by allow.
+func (b *CircuitBreaker) record(group string, outcome circuitOutcome) {
+	b.mu.Lock()
+	c := b.circuit(group)
+	from := c.state
+	now := b.now()
+
+	switch c.state {
+	case CircuitHalfOpen:
+		switch outcome {
+		case circuitFailure:
+			b.open(c, now)
+		case circuitSuccess:
+			c.successes++
+			if c.successes >= b.opts.HalfOpenRequests {
+				c.state = CircuitClosed
+				c.windowStart, c.requests, c.failures = now, 0, 0
+			}
+		case circuitIgnored:
+			c.trials--
+		}
+	case CircuitClosed:
+		if outcome == circuitIgnored {
+			break
+		}
+		if now.Sub(c.windowStart) >= b.opts.Window {
+			c.windowStart, c.requests, c.failures = now, 0, 0
+		}
+		c.requests++
+		if outcome == circuitFailure {
+			c.failures++
+		}
+		if c.requests >= b.opts.MinRequests && float64(c.failures)/float64(c.requests) >= b.opts.FailureRatio {
+			b.open(c, now)
+		}
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	b.notify(group, from, to)
+}
+
+// circuit returns the circuit for group, creating it if needed. The caller
+// must hold b.mu.
+func (b *CircuitBreaker) circuit(group string) *circuit {
+	c, ok := b.circuits[group]
+	if !ok {
+		c = &circuit{windowStart: b.now()}
+		b.circuits[group] = c
+	}
+	return c
+}
+
+// open trips c. The caller must hold b.mu.
+func (b *CircuitBreaker) open(c *circuit, now time.Time) {
+	c.state = CircuitOpen
+	c.openUntil = now.Add(b.opts.CoolDown)
+	c.requests, c.failures = 0, 0
+}
+
+// notify calls OnStateChange if the state changed.
+func (b *CircuitBreaker) notify(group string, from, to CircuitState) {
+	if from != to && b.opts.OnStateChange != nil {
+		b.opts.OnStateChange(group, from, to)
+	}
+}
@@ -0,0 +1,144 @@
+package relaywarden
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+func TestCircuitBreakerStates(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	var transitions []string
+	b := NewCircuitBreaker(CircuitBreakerOptions{
+		FailureRatio: 0.5,
+		MinRequests:  4,
+		CoolDown:     10 * time.Second,
+		OnStateChange: func(group string, from, to CircuitState) {
+			transitions = append(transitions, group+": "+from.String()+" -> "+to.String())
+		},
+	})
+	b.now = func() time.Time { return now }
+
+	for _, outcome := range []circuitOutcome{circuitSuccess, circuitFailure, circuitSuccess, circuitFailure} {
+		if err := b.allow("messages"); err != nil {
+			t.Fatalf("Expected closed circuit to allow requests, got %v", err)
+		}
+		b.record("messages", outcome)
+	}
+	if b.State("messages") != CircuitOpen {
+		t.Fatalf("Expected circuit to open at 50%% failures, got %v", b.State("messages"))
+	}
+
+	err := b.allow("messages")
+	var openErr *errors.CircuitOpenError
+	if !stderrors.As(err, &openErr) || !stderrors.Is(err, errors.ErrCircuitOpen) {
+		t.Fatalf("Expected CircuitOpenError, got %v", err)
+	}
+	if !openErr.Until.Equal(now.Add(10*time.Second)) || errors.IsRetryable(err) {
+		t.Errorf("Expected non-retryable error open until cool-down ends, got %v", openErr)
+	}
+	if b.State("management") != CircuitClosed {
+		t.Error("Expected other endpoint groups to stay closed")
+	}
+
+	now = now.Add(10 * time.Second)
+	if err := b.allow("messages"); err != nil {
+		t.Fatalf("Expected a trial request after cool-down, got %v", err)
+	}
+	if err := b.allow("messages"); err == nil {
+		t.Error("Expected only one trial request while half open")
+	}
+	b.record("messages", circuitFailure)
+	if b.State("messages") != CircuitOpen {
+		t.Fatalf("Expected failed trial to reopen the circuit, got %v", b.State("messages"))
+	}
+
+	now = now.Add(10 * time.Second)
+	if err := b.allow("messages"); err != nil {
+		t.Fatalf("Expected a trial request after cool-down, got %v", err)
+	}
+	b.record("messages", circuitSuccess)
+	if b.State("messages") != CircuitClosed {
+		t.Errorf("Expected successful trial to close the circuit, got %v", b.State("messages"))
+	}
+
+	want := []string{
+		"messages: closed -> open",
+		"messages: open -> half-open",
+		"messages: half-open -> open",
+		"messages: open -> half-open",
+		"messages: half-open -> closed",
+	}
+	if len(transitions) != len(want) {
+		t.Fatalf("Expected transitions %v, got %v", want, transitions)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("Expected transitions %v, got %v", want, transitions)
+			break
+		}
+	}
+}
+
+func TestCircuitBreakerFailsFast(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{MinRequests: 2})
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy:    &RetryPolicy{MaxAttempts: 5, DisableJitter: true},
+		CircuitBreaker: breaker,
+	})
+
+	_, err := client.Domains.ListDomains(context.Background(), nil)
+	if !stderrors.Is(err, errors.ErrCircuitOpen) {
+		t.Fatalf("Expected retries to stop once the circuit opens, got %v", err)
+	}
+	if hits.Load() != 2 {
+		t.Errorf("Expected 2 requests before the circuit opened, got %d", hits.Load())
+	}
+
+	_, err = client.WithProject("project-a").Templates.ListTemplates(context.Background(), nil)
+	if !stderrors.Is(err, errors.ErrCircuitOpen) || hits.Load() != 2 {
+		t.Errorf("Expected scoped clients to share the open circuit, got %v after %d requests", err, hits.Load())
+	}
+	if breaker.State("messages") != CircuitClosed {
+		t.Error("Expected the messages circuit to stay closed")
+	}
+}
+
+func TestCircuitCheckedBeforeRateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	breaker := NewCircuitBreaker(CircuitBreakerOptions{MinRequests: 1, FailureRatio: 0.5, CoolDown: time.Minute})
+	limiter := NewRateLimiter(1, 1)
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy:    &RetryPolicy{MaxAttempts: 1},
+		RateLimiter:    limiter,
+		CircuitBreaker: breaker,
+	})
+	client.Get(context.Background(), "/messages", nil)
+
+	start := time.Now()
+	_, err := client.Get(context.Background(), "/messages", nil)
+	var openErr *errors.CircuitOpenError
+	if !stderrors.As(err, &openErr) {
+		t.Fatalf("Expected CircuitOpenError, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected an open circuit to fail without waiting for the limiter, took %v", elapsed)
+	}
+}
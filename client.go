@@ -6,165 +6,513 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"math"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // client is the internal client for making HTTP requests.
 type client struct {
-	baseURL    string
-	token      string
-	httpClient *http.Client
-	projectID  *string
-	teamID     *string
-	maxRetries int
-	timeout    time.Duration
+	*core
+	projectID atomic.Pointer[string]
+	teamID    atomic.Pointer[string]
+}
+
+// core holds the configuration and transport shared by a client and every
+// scoped client derived from it.
+type core struct {
+	regions         *regionSet
+	tokens          TokenSource
+	httpClient      *http.Client
+	handler         Handler
+	observer        Observer
+	logger          *slog.Logger
+	logOptions      LogOptions
+	limiter         *RateLimiter
+	breaker         *CircuitBreaker
+	retry           *RetryPolicy
+	idempotencyKeys IdempotencyKeyMode
+	timeout         time.Duration
 }
 
 // ClientOptions contains optional configuration for the client.
 type ClientOptions struct {
+	// MaxRetries is the number of retries used when RetryPolicy is nil. Zero
+	// means no retries; start from DefaultClientOptions to keep the default.
 	MaxRetries int
-	Timeout    time.Duration
+	// Timeout is the timeout of each attempt, used when HTTPClient is nil.
+	// Zero means no timeout; start from DefaultClientOptions to keep the
+	// default.
+	Timeout time.Duration
+	// RetryPolicy controls how failed requests are retried. If nil, the
+	// DefaultRetryPolicy is used with MaxRetries retries.
+	RetryPolicy *RetryPolicy
+	// IdempotencyKeys controls how Idempotency-Key headers are generated for
+	// POST and PATCH requests. The same key is reused across retries.
+	IdempotencyKeys IdempotencyKeyMode
+	// HTTPClient is the HTTP client used to send requests. If nil, a client
+	// with the configured Timeout and Transport is created. When set, its own
+	// Timeout and Transport are used as-is.
+	HTTPClient *http.Client
+	// Transport is the round tripper used when HTTPClient is nil, for example
+	// to configure a proxy or TLS settings. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+	// Middleware wraps every request attempt, in order: the first middleware
+	// is the outermost and sees the request first.
+	Middleware []Middleware
+	// Observer, if set, is notified about every call and attempt, for example
+	// to record traces and metrics.
+	Observer Observer
+	// Logger, if set, receives a record for every attempt. See LogOptions
+	// for the levels used and what is redacted.
+	Logger     *slog.Logger
+	LogOptions LogOptions
+	// RateLimiter, if set, throttles requests before they are sent and
+	// follows the rate limit headers returned by the API. It is shared by
+	// all resources and scoped clients.
+	RateLimiter *RateLimiter
+	// CircuitBreaker, if set, fails calls fast with an errors.CircuitOpenError
+	// while the API is failing. It is shared by all resources and scoped
+	// clients.
+	CircuitBreaker *CircuitBreaker
+	// TokenSource, if set, supplies the API token before every attempt in
+	// place of the token passed to NewClient, so tokens can be rotated.
+	TokenSource TokenSource
+	// Regions, if set, replaces the base URL passed to NewClient with an
+	// ordered list of regional endpoints. Requests go to the first healthy
+	// region. Idempotent and idempotency-keyed requests fail over to the next
+	// region right away on connection errors and 5xx responses.
+	Regions []Region
+	// RegionCooldown is how long a failing region is avoided. Defaults to
+	// 30 seconds.
+	RegionCooldown time.Duration
 }
 
-// newClient creates a new internal client for making HTTP requests.
-func newClient(baseURL, token string, opts ...ClientOptions) *client {
-	options := ClientOptions{
+// DefaultClientOptions returns the options used when none are passed to
+// NewClient: 3 retries and a 30 second timeout. Start from it to set other
+// options while keeping these defaults:
+//
+//	opts := relaywarden.DefaultClientOptions()
+//	opts.Logger = logger
+//	client := relaywarden.NewClient(baseURL, token, opts)
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
 		MaxRetries: 3,
 		Timeout:    30 * time.Second,
 	}
+}
+
+// clientOptions returns the options passed to a constructor, or the defaults
+// if none were passed.
+func clientOptions(opts []ClientOptions) ClientOptions {
 	if len(opts) > 0 {
-		options = opts[0]
+		return opts[0]
+	}
+	return DefaultClientOptions()
+}
+
+// newClient creates a new internal client for making HTTP requests.
+func newClient(baseURL, token string, opts ...ClientOptions) *client {
+	options := clientOptions(opts)
+
+	retry := options.RetryPolicy
+	if retry == nil {
+		retry = DefaultRetryPolicy()
+		retry.MaxAttempts = max(options.MaxRetries, 0) + 1
+	}
+	timeout := max(options.Timeout, 0)
+
+	regions := options.Regions
+	if len(regions) == 0 {
+		regions = []Region{{BaseURL: baseURL}}
+	}
+
+	tokens := options.TokenSource
+	if tokens == nil {
+		tokens = StaticToken(token)
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout:   timeout,
+			Transport: options.Transport,
+		}
 	}
 
 	c := &client{
-		baseURL:    baseURL,
-		token:      token,
-		maxRetries: options.MaxRetries,
-		timeout:    options.Timeout,
-		httpClient: &http.Client{
-			Timeout: options.Timeout,
+		core: &core{
+			regions:         newRegionSet(regions, options.RegionCooldown),
+			tokens:          tokens,
+			httpClient:      httpClient,
+			handler:         chain(httpClient.Do, options.Middleware),
+			observer:        options.Observer,
+			logger:          options.Logger,
+			logOptions:      options.LogOptions,
+			limiter:         options.RateLimiter,
+			breaker:         options.CircuitBreaker,
+			retry:           retry,
+			idempotencyKeys: options.IdempotencyKeys,
+			timeout:         timeout,
 		},
 	}
 
 	return c
 }
 
-// SetProjectID sets the project ID for project-scoped operations.
+// derive returns a new client that shares c's transport and configuration
+// and starts with a copy of c's current project and team scope.
+func (c *client) derive() *client {
+	d := &client{core: c.core}
+	d.projectID.Store(c.projectID.Load())
+	d.teamID.Store(c.teamID.Load())
+	return d
+}
+
+// SetProjectID sets the project ID for project-scoped operations. It is safe
+// to call concurrently with requests.
 func (c *client) SetProjectID(projectID string) {
-	c.projectID = &projectID
+	c.projectID.Store(&projectID)
 }
 
 // GetProjectID returns the current project ID.
 func (c *client) GetProjectID() *string {
-	return c.projectID
+	return c.projectID.Load()
 }
 
-// SetTeamID sets the team ID.
+// SetTeamID sets the team ID. It is safe to call concurrently with requests.
 func (c *client) SetTeamID(teamID string) {
-	c.teamID = &teamID
+	c.teamID.Store(&teamID)
 }
 
 // GetTeamID returns the current team ID.
 func (c *client) GetTeamID() *string {
-	return c.teamID
+	return c.teamID.Load()
 }
 
-// request makes an HTTP request with retry logic.
-func (c *client) request(ctx context.Context, method, path string, body interface{}, headers map[string]string) (map[string]interface{}, error) {
-	var bodyReader io.Reader
-	if body != nil {
-		bodyBytes, err := json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+// request makes an HTTP request and returns the decoded JSON body as a map.
+func (c *client) request(ctx context.Context, method, path string, body interface{}, headers map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	bodyBytes, err := c.send(ctx, method, path, body, headers, option.NewRequestConfig(opts...))
+	if err != nil || bodyBytes == nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if len(bodyBytes) > 0 {
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, &errors.DecodeError{Err: err, Body: bodyBytes}
 		}
-		bodyReader = bytes.NewReader(bodyBytes)
 	}
+	return result, nil
+}
 
-	url := c.baseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+// Do makes an HTTP request and decodes the JSON response envelope directly into out.
+func (c *client) Do(ctx context.Context, method, path string, query url.Values, body interface{}, headers map[string]string, out interface{}, opts ...option.RequestOption) error {
+	bodyBytes, err := c.send(ctx, method, withQuery(path, query), body, headers, option.NewRequestConfig(opts...))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
 
-	// Set default headers
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	if len(bodyBytes) > 0 && out != nil {
+		if err := json.Unmarshal(bodyBytes, out); err != nil {
+			return &errors.DecodeError{Err: err, Body: bodyBytes}
+		}
+	}
+	return nil
+}
 
-	// Set project/team headers
-	if c.projectID != nil {
-		req.Header.Set("X-Project-Id", *c.projectID)
+// send makes an HTTP request with retry logic and returns the raw response body.
+// A nil body is returned for 204 No Content responses.
+func (c *client) send(ctx context.Context, method, path string, body interface{}, headers map[string]string, cfg *option.RequestConfig) (_ []byte, err error) {
+	var info option.ResponseInfo
+	var call *CallInfo
+	if c.observer != nil || c.breaker != nil {
+		call = c.callInfo(method, path, cfg)
 	}
-	if c.teamID != nil {
-		req.Header.Set("X-Team-Id", *c.teamID)
+	record := cfg.Response != nil || c.observer != nil
+	if record {
+		if c.observer != nil {
+			ctx = c.observer.StartCall(ctx, call)
+		}
+		callCtx := ctx
+		start := time.Now()
+		defer func() {
+			info.Elapsed = time.Since(start)
+			if cfg.Response != nil {
+				*cfg.Response = info
+			}
+			if c.observer != nil {
+				c.observer.EndCall(callCtx, call, &info, err)
+			}
+		}()
 	}
 
-	// Set custom headers
-	for k, v := range headers {
-		req.Header.Set(k, v)
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
 	}
+	path = withQuery(path, cfg.Query)
 
-	var lastErr error
-	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		resp, err := c.httpClient.Do(req)
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = json.Marshal(body)
 		if err != nil {
-			lastErr = err
-			if attempt < c.maxRetries {
-				time.Sleep(time.Duration(attempt+1) * 100 * time.Millisecond)
-				continue
-			}
-			return nil, fmt.Errorf("request failed: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+	}
 
-		defer resp.Body.Close()
+	// Generate the idempotency key once so every attempt carries the same one.
+	key := cfg.IdempotencyKey
+	if _, ok := headers["Idempotency-Key"]; !ok && key == "" {
+		var err error
+		key, err = c.idempotencyKeys.idempotencyKey(method, path, bodyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if key != "" {
+		withKey := make(map[string]string, len(headers)+1)
+		for k, v := range headers {
+			withKey[k] = v
+		}
+		withKey["Idempotency-Key"] = key
+		headers = withKey
+	}
+
+	var group string
+	if c.breaker != nil {
+		group = c.breaker.opts.Group(call)
+	}
+
+	var waited time.Duration
+	var tokenRefreshed bool
+	var retries, failovers int
+	tried := make([]bool, len(c.regions.list))
+	// lastErr is the error of the previous attempt, kept when a later wait
+	// fails so its idempotency key is not lost.
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		var retryAfter time.Duration
 
-		bodyBytes, err := io.ReadAll(resp.Body)
+		token, err := c.currentToken(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response: %w", err)
+			return nil, err
 		}
 
-		if resp.StatusCode == 204 {
-			return nil, nil
+		region := c.regions.pick(tried)
+		tried[region] = true
+
+		// Build a fresh request for every attempt so the body is replayed.
+		req, err := c.newRequest(ctx, method, c.regions.list[region].BaseURL+path, token, bodyBytes, headers, cfg)
+		if err != nil {
+			return nil, err
 		}
 
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			var result map[string]interface{}
-			if len(bodyBytes) > 0 {
-				if err := json.Unmarshal(bodyBytes, &result); err != nil {
-					return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		// Check the breaker first so a call rejected by an open circuit fails
+		// without waiting for the rate limiter.
+		if c.breaker != nil {
+			if err := c.breaker.allow(group); err != nil {
+				return nil, err
+			}
+		}
+		if c.limiter != nil {
+			// Time spent waiting for the limiter counts against the retry
+			// budget, and a wait that would exceed it fails right away.
+			limit := time.Duration(-1)
+			if c.retry.Budget > 0 {
+				limit = max(c.retry.Budget-waited, 0)
+			}
+			d, err := c.limiter.wait(ctx, limit)
+			waited += d
+			if err != nil {
+				if c.breaker != nil {
+					c.breaker.record(group, circuitIgnored)
 				}
+				if lastErr != nil {
+					err = fmt.Errorf("%w (last attempt: %w)", err, lastErr)
+				}
+				return nil, err
 			}
-			return result, nil
 		}
 
-		// Handle errors
-		var errorResp map[string]interface{}
-		if len(bodyBytes) > 0 {
-			if err := json.Unmarshal(bodyBytes, &errorResp); err == nil {
-				if apiErr := c.handleErrorResponse(resp, errorResp); apiErr != nil {
-					// Check if rate limit and retry
-					if rateLimitErr, ok := apiErr.(*errors.RateLimitError); ok && attempt < c.maxRetries {
-						time.Sleep(time.Duration(rateLimitErr.RetryAfter) * time.Second)
-						lastErr = apiErr
-						continue
-					}
-					return nil, apiErr
+		// Clear the response of the previous attempt, so info describes
+		// only the final one.
+		info.StatusCode, info.Header, info.RequestID, info.RateLimit = 0, nil, "", option.RateLimit{}
+		info.Attempts, info.Retries, info.Failovers = attempt, retries, failovers
+		info.Region = c.regions.list[region].Name
+		info.IdempotencyKey = req.Header.Get("Idempotency-Key")
+		c.logCurl(ctx, req, bodyBytes)
+		attemptStart := time.Now()
+		if c.observer != nil {
+			c.observer.StartAttempt(req, attempt)
+		}
+		resp, err := c.handler(req)
+		info.Latency = time.Since(attemptStart)
+		if c.breaker != nil {
+			c.breaker.record(group, attemptOutcome(ctx, resp, err))
+		}
+		failed := regionFailure(ctx, resp, err)
+		c.regions.report(region, !failed)
+		failover := failed && canFailOver(req) && c.regions.untried(tried)
+		entry := attemptLog{
+			method:    method,
+			path:      path,
+			operation: cfg.Operation,
+			region:    c.regions.list[region].Name,
+			attempt:   attempt,
+			duration:  info.Latency,
+		}
+		if err != nil {
+			if c.observer != nil {
+				c.observer.EndAttempt(req, nil, err)
+			}
+			lastErr = &errors.TransportError{
+				Err:            err,
+				IdempotencyKey: req.Header.Get("Idempotency-Key"),
+			}
+			if !failover && (ctx.Err() != nil || !c.retry.retryError(err)) {
+				c.logAttempt(ctx, &entry, lastErr)
+				return nil, lastErr
+			}
+		} else {
+			respBody, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if c.observer != nil {
+				c.observer.EndAttempt(req, resp, err)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to read response: %w", err)
+			}
+			if record {
+				recordResponse(&info, resp, respBody)
+			}
+			entry.resp, entry.body = resp, respBody
+			if c.limiter != nil {
+				c.limiter.observe(resp)
+			}
+
+			if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				c.logAttempt(ctx, &entry, nil)
+				if resp.StatusCode == 204 {
+					return nil, nil
+				}
+				return respBody, nil
+			}
+
+			lastErr = c.errorFromResponse(resp, respBody)
+
+			// Retry once right away if the token was rotated since it was read.
+			if resp.StatusCode == http.StatusUnauthorized && !tokenRefreshed {
+				tokenRefreshed = true
+				if c.tokenRotated(ctx, token) {
+					entry.retrying = true
+					c.logAttempt(ctx, &entry, lastErr)
+					continue
 				}
 			}
+			if !failover && !c.retry.retryStatus(resp.StatusCode) {
+				c.logAttempt(ctx, &entry, lastErr)
+				return nil, lastErr
+			}
+			if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				retryAfter = ra
+			}
 		}
 
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		// Failing over to another region does not count as a retry.
+		if failover {
+			failovers++
+			entry.retrying, entry.failover = true, true
+			c.logAttempt(ctx, &entry, lastErr)
+			continue
+		}
+
+		if attempt-failovers >= c.retry.maxAttempts() {
+			c.logAttempt(ctx, &entry, lastErr)
+			return nil, lastErr
+		}
+
+		delay := retryAfter
+		if delay == 0 {
+			delay = c.retry.backoff(attempt - failovers)
+		}
+		if c.retry.Budget > 0 && waited+delay > c.retry.Budget {
+			c.logAttempt(ctx, &entry, lastErr)
+			return nil, lastErr
+		}
+		entry.retrying, entry.retryIn = true, delay
+		c.logAttempt(ctx, &entry, lastErr)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("%w (last attempt: %w)", err, lastErr)
+		}
+		waited += delay
+		retries++
+	}
+}
+
+// newRequest creates an HTTP request with the default, scope and custom headers
+// set. Per-call options take precedence over the client configuration.
+func (c *client) newRequest(ctx context.Context, method, url, token string, bodyBytes []byte, headers map[string]string, cfg *option.RequestConfig) (*http.Request, error) {
+	var bodyReader io.Reader
+	if bodyBytes != nil {
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set default headers
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	// Set project/team headers
+	if projectID := c.projectID.Load(); projectID != nil {
+		req.Header.Set("X-Project-Id", *projectID)
+	}
+	if teamID := c.teamID.Load(); teamID != nil {
+		req.Header.Set("X-Team-Id", *teamID)
+	}
+	if cfg.ProjectID != "" {
+		req.Header.Set("X-Project-Id", cfg.ProjectID)
+	}
+	if cfg.TeamID != "" {
+		req.Header.Set("X-Team-Id", cfg.TeamID)
 	}
 
-	if lastErr != nil {
-		return nil, lastErr
+	// Set custom headers
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	for k, v := range cfg.Header {
+		req.Header[k] = v
 	}
 
-	return nil, fmt.Errorf("request failed after %d retries", c.maxRetries)
+	return req, nil
+}
+
+// errorFromResponse converts an unsuccessful response into an error. Bodies
+// that are not JSON, such as HTML pages from a proxy, are classified by status
+// code alone.
+func (c *client) errorFromResponse(resp *http.Response, bodyBytes []byte) error {
+	var errorResp map[string]interface{}
+	if len(bodyBytes) > 0 {
+		if err := json.Unmarshal(bodyBytes, &errorResp); err != nil {
+			errorResp = nil
+		}
+	}
+	return c.handleErrorResponse(resp, errorResp)
 }
 
 // handleErrorResponse parses error responses and returns appropriate error types.
@@ -180,6 +528,9 @@ func (c *client) handleErrorResponse(resp *http.Response, body map[string]interf
 			requestID = id
 		}
 	}
+	if requestID == "" {
+		requestID = resp.Header.Get("X-Request-Id")
+	}
 
 	if err, ok := body["error"].(map[string]interface{}); ok {
 		if code, ok := err["code"].(string); ok {
@@ -202,6 +553,8 @@ func (c *client) handleErrorResponse(resp *http.Response, body map[string]interf
 				}
 			}
 		}
+	} else if text := http.StatusText(statusCode); text != "" {
+		message = text
 	}
 
 	apiErr := &errors.APIError{
@@ -211,56 +564,81 @@ func (c *client) handleErrorResponse(resp *http.Response, body map[string]interf
 		RequestID: requestID,
 		Details:   details,
 	}
+	if resp.Request != nil {
+		apiErr.IdempotencyKey = resp.Request.Header.Get("Idempotency-Key")
+	}
 
-	switch statusCode {
-	case 401:
+	switch {
+	case statusCode == 401:
 		return &errors.AuthenticationError{APIError: apiErr}
-	case 422:
+	case statusCode == 403:
+		return &errors.ForbiddenError{APIError: apiErr}
+	case statusCode == 404:
+		return &errors.NotFoundError{APIError: apiErr}
+	case statusCode == 409, statusCode == 412:
+		return &errors.ConflictError{APIError: apiErr}
+	case statusCode == 422:
 		return &errors.ValidationErrorResponse{APIError: apiErr}
-	case 429:
+	case statusCode == 429:
 		retryAfter := 60
-		if retryHeader := resp.Header.Get("Retry-After"); retryHeader != "" {
-			if ra, err := time.ParseDuration(retryHeader + "s"); err == nil {
-				retryAfter = int(ra.Seconds())
-			}
+		if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			retryAfter = int(math.Ceil(ra.Seconds()))
 		}
 		return &errors.RateLimitError{
 			APIError:   apiErr,
 			RetryAfter: retryAfter,
 		}
+	case statusCode >= 500:
+		return &errors.ServerError{APIError: apiErr}
 	default:
 		return apiErr
 	}
 }
 
-// Get makes a GET request.
-func (c *client) Get(ctx context.Context, path string, query map[string]string) (map[string]interface{}, error) {
-	if len(query) > 0 {
-		path += "?"
-		first := true
-		for k, v := range query {
-			if !first {
-				path += "&"
-			}
-			path += k + "=" + url.QueryEscape(v)
-			first = false
-		}
+// withQuery adds query parameters to path. Parameters already present in
+// path are kept, and the result is encoded in key order so equivalent
+// requests produce identical URLs.
+func withQuery(path string, query url.Values) string {
+	if len(query) == 0 {
+		return path
+	}
+	base, raw, _ := strings.Cut(path, "?")
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return path + "&" + query.Encode()
+	}
+	for k, vs := range query {
+		values[k] = append(values[k], vs...)
+	}
+	return base + "?" + values.Encode()
+}
+
+// queryValues converts a map of query parameters to url.Values.
+func queryValues(query map[string]string) url.Values {
+	values := make(url.Values, len(query))
+	for k, v := range query {
+		values.Set(k, v)
 	}
-	return c.request(ctx, "GET", path, nil, nil)
+	return values
+}
+
+// Get makes a GET request.
+func (c *client) Get(ctx context.Context, path string, query map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return c.request(ctx, "GET", withQuery(path, queryValues(query)), nil, nil, opts...)
 }
 
 // Post makes a POST request.
-func (c *client) Post(ctx context.Context, path string, body interface{}, headers map[string]string) (map[string]interface{}, error) {
-	return c.request(ctx, "POST", path, body, headers)
+func (c *client) Post(ctx context.Context, path string, body interface{}, headers map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return c.request(ctx, "POST", path, body, headers, opts...)
 }
 
 // Patch makes a PATCH request.
-func (c *client) Patch(ctx context.Context, path string, body interface{}) (map[string]interface{}, error) {
-	return c.request(ctx, "PATCH", path, body, nil)
+func (c *client) Patch(ctx context.Context, path string, body interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return c.request(ctx, "PATCH", path, body, nil, opts...)
 }
 
 // Delete makes a DELETE request.
-func (c *client) Delete(ctx context.Context, path string) error {
-	_, err := c.request(ctx, "DELETE", path, nil, nil)
+func (c *client) Delete(ctx context.Context, path string, opts ...option.RequestOption) error {
+	_, err := c.request(ctx, "DELETE", path, nil, nil, opts...)
 	return err
 }
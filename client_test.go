@@ -3,11 +3,20 @@ package relaywarden
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 func TestNewClient(t *testing.T) {
@@ -119,3 +128,588 @@ func TestRateLimitError(t *testing.T) {
 		t.Errorf("Expected RateLimitError, got %T", err)
 	}
 }
+
+func TestTypedSendMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Expected JSON body, got %v", err)
+		}
+		if body["subject"] != "Hello" {
+			t.Errorf("Expected subject 'Hello', got %v", body["subject"])
+		}
+		if r.Header.Get("Idempotency-Key") != "key-1" {
+			t.Errorf("Expected Idempotency-Key header, got %q", r.Header.Get("Idempotency-Key"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"message_id": "msg-123",
+				"status":     "accepted",
+			},
+			"meta": map[string]interface{}{
+				"request_id": "req-123",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	message, err := client.Messages.SendMessage(context.Background(), &models.SendMessageRequest{
+		From:    models.Address{Email: "noreply@example.com"},
+		To:      []models.Address{{Email: "user@example.com"}},
+		Subject: "Hello",
+		HTML:    "<h1>Hello</h1>",
+	}, "key-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if message.ID != "msg-123" || message.Status != "accepted" {
+		t.Errorf("Unexpected message: %+v", message)
+	}
+}
+
+func TestSendRaw(t *testing.T) {
+	var got models.SendMessageRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("Expected JSON body, got %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"message_id":"msg-raw","status":"accepted"}}`))
+	}))
+	defer server.Close()
+
+	raw := "From: Alice <alice@example.org>\r\nTo: bob@example.com\r\nSubject: Hi\r\n\r\nHello Bob\r\n"
+	client := NewClient(server.URL, "test-token")
+	message, err := client.Messages.SendRaw(context.Background(), strings.NewReader(raw))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if message.ID != "msg-raw" || got.From.Name != "Alice" || got.To[0].Email != "bob@example.com" || got.Text != "Hello Bob\n" {
+		t.Errorf("Unexpected request %+v or message %+v", got, message)
+	}
+
+	_, err = client.Messages.SendRaw(context.Background(), strings.NewReader("From: alice@example.org\r\nSubject: Hi\r\n\r\nHi\r\n"))
+	if !stderrors.Is(err, errors.ErrValidation) {
+		t.Errorf("Expected a validation error for a message without recipients, got %v", err)
+	}
+}
+
+func TestTypedList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "dom-1", "name": "example.com", "status": "verified"},
+				{"id": "dom-2", "name": "example.org", "status": "pending"},
+			},
+			"meta": map[string]interface{}{
+				"current_page": 1,
+				"per_page":     25,
+				"total":        2,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	domains, err := client.Domains.ListDomains(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(domains.Data) != 2 || domains.Data[1].Name != "example.org" {
+		t.Errorf("Unexpected domains: %+v", domains.Data)
+	}
+	if domains.Meta.Total != 2 || domains.Meta.PerPage != 25 {
+		t.Errorf("Unexpected meta: %+v", domains.Meta)
+	}
+}
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/proj-1" {
+			t.Errorf("Expected path /projects/proj-1, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":          "proj-1",
+				"name":        "Production",
+				"environment": "production",
+			},
+			"meta": map[string]interface{}{
+				"request_id": "req-456",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	resp, err := Do[models.Project](context.Background(), client, "GET", "/projects/proj-1", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.Data.Name != "Production" {
+		t.Errorf("Expected name 'Production', got %q", resp.Data.Name)
+	}
+	if resp.Meta.RequestID != "req-456" {
+		t.Errorf("Expected request ID 'req-456', got %q", resp.Meta.RequestID)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("per_page") != "2" {
+			t.Errorf("Expected per_page=2, got %q", r.URL.Query().Get("per_page"))
+		}
+		pages := map[string][]map[string]interface{}{
+			"1": {{"id": "evt-1"}, {"id": "evt-2"}},
+			"2": {{"id": "evt-3"}},
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": pages[strconv.Itoa(page)],
+			"meta": map[string]interface{}{
+				"current_page": page,
+				"per_page":     2,
+				"total":        3,
+				"last_page":    2,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	var ids []string
+	for event, err := range client.Events.All(context.Background(), &models.EventFilter{ListOptions: models.ListOptions{PerPage: 2}}) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, event.ID)
+	}
+	if len(ids) != 3 || ids[2] != "evt-3" {
+		t.Errorf("Expected 3 events, got %v", ids)
+	}
+}
+
+func TestCursorPagination(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		data := []map[string]interface{}{{"id": "log-1"}, {"id": "log-2"}}
+		meta := map[string]interface{}{"next_cursor": "abc"}
+		if r.URL.Query().Get("cursor") == "abc" {
+			if r.URL.Query().Get("page") != "" {
+				t.Errorf("Expected no page parameter with cursor, got %q", r.URL.Query().Get("page"))
+			}
+			data = []map[string]interface{}{{"id": "log-3"}}
+			meta = map[string]interface{}{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data, "meta": meta})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	var ids []string
+	for log, err := range client.AuditLogs.All(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, log.ID)
+	}
+	if len(ids) != 3 || requests != 2 {
+		t.Errorf("Expected 3 logs over 2 requests, got %v over %d", ids, requests)
+	}
+
+	// Breaking out of the loop must not fetch further pages.
+	requests = 0
+	for range client.AuditLogs.All(context.Background(), nil) {
+		break
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request after break, got %d", requests)
+	}
+}
+
+func TestPaginationContextCanceled(t *testing.T) {
+	client := NewClient("http://127.0.0.1:0", "test-token")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, err := range client.Messages.All(ctx, nil) {
+		if err != context.Canceled {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		sentinel    error
+		check       func(t *testing.T, err error)
+	}{
+		{"forbidden", 403, "application/json", `{"error":{"code":"forbidden","message":"Missing ability"}}`, errors.ErrForbidden, func(t *testing.T, err error) {
+			if e, ok := err.(*errors.ForbiddenError); !ok || e.Message != "Missing ability" {
+				t.Errorf("Expected ForbiddenError, got %T: %v", err, err)
+			}
+		}},
+		{"not found", 404, "application/json", `{"error":{"code":"not_found","message":"Domain not found"},"meta":{"request_id":"req-1"}}`, errors.ErrNotFound, func(t *testing.T, err error) {
+			if e, ok := err.(*errors.NotFoundError); !ok || e.RequestID != "req-1" {
+				t.Errorf("Expected NotFoundError, got %T: %v", err, err)
+			}
+		}},
+		{"precondition failed", 412, "application/json", `{"error":{"code":"precondition_failed","message":"Version mismatch"}}`, errors.ErrConflict, func(t *testing.T, err error) {
+			if _, ok := err.(*errors.ConflictError); !ok {
+				t.Errorf("Expected ConflictError, got %T", err)
+			}
+		}},
+		{"HTML server error", 500, "text/html", `<html><body>Internal Server Error</body></html>`, errors.ErrServer, func(t *testing.T, err error) {
+			e, ok := err.(*errors.ServerError)
+			if !ok {
+				t.Fatalf("Expected ServerError, got %T", err)
+			}
+			if e.Message != "Internal Server Error" || e.RequestID != "req-proxy" {
+				t.Errorf("Unexpected error details: %+v", e.APIError)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.Header().Set("X-Request-Id", "req-proxy")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-token")
+			_, err := client.Get(context.Background(), "/test", nil)
+			if !stderrors.Is(err, tt.sentinel) {
+				t.Errorf("Expected error to match %v, got %v", tt.sentinel, err)
+			}
+			tt.check(t, err)
+		})
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	_, err := client.Messages.GetMessage(context.Background(), "msg-1")
+	var decodeErr *errors.DecodeError
+	if !stderrors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T", err)
+	}
+	if string(decodeErr.Body) != `{"data":` {
+		t.Errorf("Expected raw body on DecodeError, got %q", decodeErr.Body)
+	}
+}
+
+func TestWithResponse(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.Header().Set("X-RateLimit-Reset", "1767225600")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"message_id": "msg-123"},
+			"meta": map[string]interface{}{"request_id": "req-789"},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	})
+	var info option.ResponseInfo
+	_, err := client.Messages.SendMessage(context.Background(), &models.SendMessageRequest{}, "", option.WithResponse(&info))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if info.StatusCode != http.StatusAccepted || info.RequestID != "req-789" || info.Attempts != 2 || info.Retries != 1 {
+		t.Errorf("Unexpected response info: %+v", info)
+	}
+	if info.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Expected response headers, got %v", info.Header)
+	}
+	if info.RateLimit.Limit != 100 || info.RateLimit.Remaining != 99 || info.RateLimit.Reset.Unix() != 1767225600 {
+		t.Errorf("Unexpected rate limit: %+v", info.RateLimit)
+	}
+	if info.IdempotencyKey == "" {
+		t.Error("Expected idempotency key to be recorded")
+	}
+	if info.Latency <= 0 || info.Elapsed < info.Latency {
+		t.Errorf("Unexpected timing: latency %v, elapsed %v", info.Latency, info.Elapsed)
+	}
+}
+
+func TestWithResponseOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-404")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	var info option.ResponseInfo
+	if err := client.Domains.Delete(context.Background(), "dom-1", option.WithResponse(&info)); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if info.StatusCode != http.StatusNotFound || info.RequestID != "req-404" {
+		t.Errorf("Unexpected response info: %+v", info)
+	}
+}
+
+func TestWithResponseAfterTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-503")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var attempts int
+	failSecond := func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if attempts++; attempts == 2 {
+				return nil, stderrors.New("connection reset")
+			}
+			return next(req)
+		}
+	}
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+		Middleware:  []Middleware{failSecond},
+	})
+	var info option.ResponseInfo
+	if _, err := client.Get(context.Background(), "/identity", nil, option.WithResponse(&info)); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if info.Attempts != 2 || info.StatusCode != 0 || info.Header != nil || info.RequestID != "" || info.RateLimit.Limit != 0 {
+		t.Errorf("Expected no response from the final attempt, got %+v", info)
+	}
+}
+
+// bodyObserver records whether the response body could still be read when
+// EndAttempt was called.
+type bodyObserver struct {
+	nopObserver
+	readErr error
+}
+
+func (o *bodyObserver) EndAttempt(req *http.Request, resp *http.Response, err error) {
+	_, o.readErr = resp.Body.Read(make([]byte, 1))
+}
+
+func TestObserverEndAttemptAfterBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	observer := &bodyObserver{}
+	client := NewClient(server.URL, "test-token", ClientOptions{Observer: observer})
+	if _, err := client.Get(context.Background(), "/identity", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if observer.readErr == nil {
+		t.Error("Expected the response body to be consumed before EndAttempt")
+	}
+}
+
+func TestRequestOptions(t *testing.T) {
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	client.SetProjectID("project-default")
+
+	_, err := client.Templates.Create(context.Background(), map[string]interface{}{"name": "Welcome"},
+		option.WithProject("project-other"),
+		option.WithTeam("team-1"),
+		option.WithHeader("X-Trace", "abc"),
+		option.WithIdempotencyKey("key-42"),
+		option.WithQuery("dry_run", "true"),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.Header.Get("X-Project-Id") != "project-other" || got.Header.Get("X-Team-Id") != "team-1" {
+		t.Errorf("Expected per-call scope headers, got project %q team %q",
+			got.Header.Get("X-Project-Id"), got.Header.Get("X-Team-Id"))
+	}
+	if got.Header.Get("X-Trace") != "abc" || got.Header.Get("Idempotency-Key") != "key-42" {
+		t.Errorf("Expected custom headers, got %v", got.Header)
+	}
+	if got.URL.Query().Get("dry_run") != "true" {
+		t.Errorf("Expected dry_run query parameter, got %q", got.URL.RawQuery)
+	}
+	if id := client.GetProjectID(); id == nil || *id != "project-default" {
+		t.Errorf("Expected client project ID to be unchanged, got %v", id)
+	}
+
+	if _, err := client.Messages.List(context.Background(), map[string]string{"status": "bounced"}, option.WithQuery("per_page", "10")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.URL.Query().Get("status") != "bounced" || got.URL.Query().Get("per_page") != "10" {
+		t.Errorf("Expected filters and extra query to be merged, got %q", got.URL.RawQuery)
+	}
+	if got.Header.Get("X-Project-Id") != "project-default" {
+		t.Errorf("Expected client project ID on later calls, got %q", got.Header.Get("X-Project-Id"))
+	}
+}
+
+func TestInvalidIDNotSent(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	ctx := context.Background()
+	if _, err := client.Domains.VerifyDomain(ctx, ""); !stderrors.Is(err, errors.ErrInvalidArgument) {
+		t.Errorf("Expected invalid argument error for an empty ID, got %v", err)
+	}
+	if err := client.Templates.Delete(ctx, ".."); !stderrors.Is(err, errors.ErrInvalidArgument) {
+		t.Errorf("Expected invalid argument error for a relative segment, got %v", err)
+	}
+	for _, err := range client.Webhooks.AllDeliveries(ctx, "", nil) {
+		if !stderrors.Is(err, errors.ErrInvalidArgument) {
+			t.Errorf("Expected invalid argument error from the iterator, got %v", err)
+		}
+	}
+	if hits.Load() != 0 {
+		t.Errorf("Expected no requests for invalid IDs, got %d", hits.Load())
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		query url.Values
+		want  string
+	}{
+		{"no query", "/messages", nil, "/messages"},
+		{"sorted keys", "/messages", url.Values{"status[]": {"bounced", "failed"}, "page": {"1"}}, "/messages?page=1&status%5B%5D=bounced&status%5B%5D=failed"},
+		{"escaped values", "/suppressions", url.Values{"email": {"a+b@example.com"}}, "/suppressions?email=a%2Bb%40example.com"},
+		{"existing query", "/events?type=open", url.Values{"per_page": {"10"}, "type": {"click"}}, "/events?per_page=10&type=open&type=click"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withQuery(tt.path, tt.query); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	start := time.Now()
+	_, err := client.Usage.GetLimits(context.Background(), option.WithTimeout(50*time.Millisecond))
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected call to stop after the timeout, took %v", elapsed)
+	}
+}
+
+func TestWithProject(t *testing.T) {
+	var mu sync.Mutex
+	projects := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		projects[r.Header.Get("X-Project-Id")+"/"+r.Header.Get("X-Team-Id")] = true
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	client.SetTeamID("team-1")
+	tenantA := client.WithProject("project-a")
+	tenantB := client.WithProject("project-b").WithTeam("team-2")
+
+	if tenantA.httpClient != client.httpClient || tenantB.retry != client.retry {
+		t.Error("Expected derived clients to share the transport and configuration")
+	}
+	if client.GetProjectID() != nil {
+		t.Errorf("Expected parent project ID to be unchanged, got %v", *client.GetProjectID())
+	}
+
+	for _, c := range []*Client{client, tenantA, tenantB} {
+		if _, err := c.Projects.GetProject(context.Background(), "p"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	for _, want := range []string{"/team-1", "project-a/team-1", "project-b/team-2"} {
+		if !projects[want] {
+			t.Errorf("Expected a request scoped to %q, got %v", want, projects)
+		}
+	}
+}
+
+func TestSetProjectIDConcurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.SetProjectID("project-" + strconv.Itoa(i))
+			client.SetTeamID("team-" + strconv.Itoa(i))
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := client.Identity.GetIdentity(context.Background()); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			client.WithProject("scoped").GetTeamID()
+		}()
+	}
+	wg.Wait()
+}
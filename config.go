@@ -0,0 +1,174 @@
+package relaywarden
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+// Environment variables read by ConfigFromEnv.
+const (
+	EnvToken      = "RELAYWARDEN_TOKEN"
+	EnvBaseURL    = "RELAYWARDEN_BASE_URL"
+	EnvRegion     = "RELAYWARDEN_REGION"
+	EnvProjectID  = "RELAYWARDEN_PROJECT_ID"
+	EnvTeamID     = "RELAYWARDEN_TEAM_ID"
+	EnvMaxRetries = "RELAYWARDEN_MAX_RETRIES"
+	EnvTimeout    = "RELAYWARDEN_TIMEOUT"
+)
+
+// Regions maps region names to their API base URLs.
+var Regions = map[string]string{
+	"eu": "https://api.relaywarden.eu/api/v1",
+}
+
+// Config holds the settings needed to construct a Client.
+type Config struct {
+	// Token is the API token.
+	Token string
+	// BaseURL is the API base URL. It takes precedence over Region.
+	BaseURL string
+	// Region selects the base URL from Regions when BaseURL is empty.
+	Region string
+	// ProjectID and TeamID set the initial scope of the client.
+	ProjectID string
+	TeamID    string
+	// MaxRetries overrides the number of retries if set.
+	MaxRetries *int
+	// Timeout overrides the HTTP client timeout if non-zero.
+	Timeout time.Duration
+	// Source describes where the configuration was read from, such as a file
+	// and profile, in the errors reported by Validate.
+	Source string
+}
+
+// ConfigFromEnv reads the configuration from RELAYWARDEN_* environment
+// variables. It does not validate the result; see Config.Validate.
+func ConfigFromEnv() (*Config, error) {
+	cfg := &Config{Source: "environment"}
+	var problems []string
+	applyEnv(cfg, &problems)
+	if len(problems) > 0 {
+		return nil, &errors.ConfigError{Source: cfg.Source, Problems: problems}
+	}
+	return cfg, nil
+}
+
+// applyEnv overrides cfg with the RELAYWARDEN_* environment variables that
+// are set, appending parse errors to problems.
+func applyEnv(cfg *Config, problems *[]string) {
+	for env, field := range map[string]*string{
+		EnvToken:     &cfg.Token,
+		EnvBaseURL:   &cfg.BaseURL,
+		EnvRegion:    &cfg.Region,
+		EnvProjectID: &cfg.ProjectID,
+		EnvTeamID:    &cfg.TeamID,
+	} {
+		if v, ok := os.LookupEnv(env); ok {
+			*field = v
+		}
+	}
+	if v, ok := os.LookupEnv(EnvMaxRetries); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			*problems = append(*problems, fmt.Sprintf("%s=%q is not an integer", EnvMaxRetries, v))
+		} else {
+			cfg.MaxRetries = &n
+		}
+	}
+	if v, ok := os.LookupEnv(EnvTimeout); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			*problems = append(*problems, fmt.Sprintf("%s=%q is not a duration such as \"30s\"", EnvTimeout, v))
+		} else {
+			cfg.Timeout = d
+		}
+	}
+}
+
+// Validate checks that the configuration is complete and well-formed. It
+// returns an *errors.ConfigError listing every problem found.
+func (c *Config) Validate() error {
+	var problems []string
+	if c.Token == "" {
+		problems = append(problems, "token is required")
+	}
+	switch {
+	case c.BaseURL != "":
+		u, err := url.Parse(c.BaseURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("base URL %q must be an absolute http or https URL", c.BaseURL))
+		}
+	case c.Region != "":
+		if _, ok := Regions[c.Region]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown region %q", c.Region))
+		}
+	default:
+		problems = append(problems, "base URL or region is required")
+	}
+	if c.MaxRetries != nil && *c.MaxRetries < 0 {
+		problems = append(problems, "max retries must not be negative")
+	}
+	if c.Timeout < 0 {
+		problems = append(problems, "timeout must not be negative")
+	}
+
+	if len(problems) > 0 {
+		source := c.Source
+		if source == "" {
+			source = "config"
+		}
+		return &errors.ConfigError{Source: source, Problems: problems}
+	}
+	return nil
+}
+
+// baseURL returns the configured base URL, resolving the region if needed.
+func (c *Config) baseURL() string {
+	if c.BaseURL != "" {
+		return strings.TrimRight(c.BaseURL, "/")
+	}
+	return Regions[c.Region]
+}
+
+// NewClientWithConfig validates cfg and creates a client from it. Settings in
+// cfg override the corresponding fields of opts. Without opts,
+// DefaultClientOptions is used.
+func NewClientWithConfig(cfg *Config, opts ...ClientOptions) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	options := clientOptions(opts)
+	if cfg.MaxRetries != nil {
+		options.MaxRetries = *cfg.MaxRetries
+	}
+	if cfg.Timeout > 0 {
+		options.Timeout = cfg.Timeout
+	}
+
+	client := NewClient(cfg.baseURL(), cfg.Token, options)
+	if cfg.ProjectID != "" {
+		client.SetProjectID(cfg.ProjectID)
+	}
+	if cfg.TeamID != "" {
+		client.SetTeamID(cfg.TeamID)
+	}
+	return client, nil
+}
+
+// NewClientFromEnv creates a client configured by RELAYWARDEN_* environment
+// variables, returning an *errors.ConfigError if they are incomplete or
+// invalid.
+func NewClientFromEnv(opts ...ClientOptions) (*Client, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewClientWithConfig(cfg, opts...)
+}
@@ -0,0 +1,153 @@
+// Package config loads RelayWarden client configuration from YAML and TOML
+// files with named profiles:
+//
+//	client, err := config.NewClient("/etc/relaywarden.yaml")
+//	if err != nil {
+//		return err
+//	}
+//
+// It is a separate package so that programs configured from the environment
+// with relaywarden.NewClientFromEnv do not depend on the file parsers.
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	relaywarden "github.com/relaywarden/go-sdk"
+	"github.com/relaywarden/go-sdk/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvProfile is the environment variable that selects the profile loaded by
+// Load when none is given.
+const EnvProfile = "RELAYWARDEN_PROFILE"
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// profile is a named profile in a configuration file.
+type profile struct {
+	Token      string `yaml:"token" toml:"token"`
+	BaseURL    string `yaml:"base_url" toml:"base_url"`
+	Region     string `yaml:"region" toml:"region"`
+	ProjectID  string `yaml:"project_id" toml:"project_id"`
+	TeamID     string `yaml:"team_id" toml:"team_id"`
+	MaxRetries *int   `yaml:"max_retries" toml:"max_retries"`
+	Timeout    string `yaml:"timeout" toml:"timeout"`
+}
+
+// Load reads a profile from a YAML (.yaml, .yml) or TOML (.toml)
+// configuration file in which every top-level key names a profile:
+//
+//	default:
+//	  token: rw_live_...
+//	  region: eu
+//	staging:
+//	  token: rw_test_...
+//	  base_url: https://staging.example.com/api/v1
+//	  timeout: 10s
+//
+// If name is empty, the profile named by RELAYWARDEN_PROFILE is used, or
+// DefaultProfile. It does not validate the result; see
+// relaywarden.Config.Validate.
+func Load(path, name string) (*relaywarden.Config, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	profiles, err := readProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for name := range profiles {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		return nil, &errors.ConfigError{
+			Source:   path,
+			Problems: []string{fmt.Sprintf("profile %q not found (available: %s)", name, strings.Join(names, ", "))},
+		}
+	}
+
+	cfg := &relaywarden.Config{
+		Token:      p.Token,
+		BaseURL:    p.BaseURL,
+		Region:     p.Region,
+		ProjectID:  p.ProjectID,
+		TeamID:     p.TeamID,
+		MaxRetries: p.MaxRetries,
+		Source:     fmt.Sprintf("%s (profile %q)", path, name),
+	}
+	if p.Timeout != "" {
+		cfg.Timeout, err = time.ParseDuration(p.Timeout)
+		if err != nil {
+			return nil, &errors.ConfigError{
+				Source:   cfg.Source,
+				Problems: []string{fmt.Sprintf("timeout %q is not a duration such as \"30s\"", p.Timeout)},
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// readProfiles decodes every profile of a configuration file, rejecting
+// unknown settings.
+func readProfiles(path string) (map[string]profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var profiles map[string]profile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&profiles); err != nil {
+			return nil, &errors.ConfigError{Source: path, Problems: []string{err.Error()}}
+		}
+	case ".toml":
+		md, err := toml.Decode(string(data), &profiles)
+		if err != nil {
+			return nil, &errors.ConfigError{Source: path, Problems: []string{err.Error()}}
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			problems := make([]string, len(undecoded))
+			for i, key := range undecoded {
+				problems[i] = fmt.Sprintf("unknown setting %q", key.String())
+			}
+			return nil, &errors.ConfigError{Source: path, Problems: problems}
+		}
+	default:
+		return nil, &errors.ConfigError{
+			Source:   path,
+			Problems: []string{fmt.Sprintf("unsupported file extension %q, use .yaml, .yml or .toml", ext)},
+		}
+	}
+	return profiles, nil
+}
+
+// NewClient creates a client from the profile selected by RELAYWARDEN_PROFILE,
+// or the default profile, of a configuration file. See Load for the file
+// format. Use Load and relaywarden.NewClientWithConfig to select a profile
+// explicitly.
+func NewClient(path string, opts ...relaywarden.ClientOptions) (*relaywarden.Client, error) {
+	cfg, err := Load(path, "")
+	if err != nil {
+		return nil, err
+	}
+	return relaywarden.NewClientWithConfig(cfg, opts...)
+}
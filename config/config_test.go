@@ -0,0 +1,125 @@
+package config
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadProfiles(t *testing.T) {
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	yamlPath := writeConfig(t, "relaywarden.yaml", `
+default:
+  token: live-token
+  region: eu
+staging:
+  token: test-token
+  base_url: `+server.URL+`/api/v1/
+  project_id: project-1
+  max_retries: 1
+  timeout: 5s
+`)
+	tomlPath := writeConfig(t, "relaywarden.toml", `
+[default]
+token = "live-token"
+region = "eu"
+
+[staging]
+token = "test-token"
+base_url = "`+server.URL+`/api/v1/"
+project_id = "project-1"
+max_retries = 1
+timeout = "5s"
+`)
+
+	for _, path := range []string{yamlPath, tomlPath} {
+		t.Run(filepath.Ext(path), func(t *testing.T) {
+			cfg, err := Load(path, "")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if cfg.Token != "live-token" || cfg.Region != "eu" {
+				t.Errorf("Expected default profile, got %+v", cfg)
+			}
+
+			cfg, err = Load(path, "staging")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if cfg.MaxRetries == nil || *cfg.MaxRetries != 1 || cfg.Timeout != 5*time.Second {
+				t.Errorf("Expected retry and timeout settings from profile, got %+v", cfg)
+			}
+
+			t.Setenv(EnvProfile, "staging")
+			client, err := NewClient(path)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if _, err := client.Get(context.Background(), "/identity", nil); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got.URL.Path != "/api/v1/identity" || got.Header.Get("Authorization") != "Bearer test-token" {
+				t.Errorf("Expected staging base URL and token, got %q %q", got.URL.Path, got.Header.Get("Authorization"))
+			}
+			if got.Header.Get("X-Project-Id") != "project-1" {
+				t.Errorf("Expected project ID from profile, got %q", got.Header.Get("X-Project-Id"))
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    string
+	}{
+		{"missing profile", "a.yaml", "default:\n  token: x\nprod:\n  token: y\n", `profile "other" not found (available: default, prod)`},
+		{"unknown yaml key", "b.yaml", "other:\n  tokn: x\n", "field tokn not found"},
+		{"unknown toml key", "c.toml", "[other]\ntokn = \"x\"\n", `unknown setting "other.tokn"`},
+		{"bad timeout", "d.yaml", "other:\n  timeout: soon\n", `timeout "soon" is not a duration`},
+		{"extension", "e.json", "{}", `unsupported file extension ".json"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeConfig(t, tt.file, tt.content), "other")
+			var configErr *errors.ConfigError
+			if !stderrors.As(err, &configErr) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected ConfigError containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	cfg, err := Load(writeConfig(t, "f.yaml", "other:\n  region: mars\n"), "other")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), `f.yaml (profile "other")`) {
+		t.Errorf("Expected validation errors to name the file and profile, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package relaywarden
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+func clientToken(t *testing.T, client *Client) string {
+	t.Helper()
+	token, err := client.tokens.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return token
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvBaseURL, "https://api.example.com/api/v1")
+	t.Setenv(EnvTeamID, "team-1")
+	t.Setenv(EnvTimeout, "2s")
+
+	client, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if clientToken(t, client) != "env-token" || client.regions.list[0].BaseURL != "https://api.example.com/api/v1" {
+		t.Errorf("Expected token and base URL from environment, got %q %q", clientToken(t, client), client.regions.list[0].BaseURL)
+	}
+	if id := client.GetTeamID(); id == nil || *id != "team-1" {
+		t.Errorf("Expected team ID from environment, got %v", id)
+	}
+	if client.retry.MaxAttempts != 4 || client.httpClient.Timeout != 2*time.Second {
+		t.Errorf("Expected default retries and timeout from environment, got %d attempts and %v",
+			client.retry.MaxAttempts, client.httpClient.Timeout)
+	}
+
+	t.Setenv(EnvMaxRetries, "three")
+	if _, err := NewClientFromEnv(); err == nil || !strings.Contains(err.Error(), `RELAYWARDEN_MAX_RETRIES="three" is not an integer`) {
+		t.Errorf("Expected invalid max retries error, got %v", err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	retries := -1
+	err := (&Config{Region: "mars", MaxRetries: &retries}).Validate()
+
+	var configErr *errors.ConfigError
+	if !stderrors.As(err, &configErr) {
+		t.Fatalf("Expected ConfigError, got %v", err)
+	}
+	want := []string{"token is required", `unknown region "mars"`, "max retries must not be negative"}
+	if strings.Join(configErr.Problems, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected problems %q, got %q", want, configErr.Problems)
+	}
+
+	if err := (&Config{Token: "x", BaseURL: "api.example.com"}).Validate(); err == nil {
+		t.Error("Expected relative base URL to be rejected")
+	}
+}
+
+type nopObserver struct{}
+
+func (nopObserver) StartCall(ctx context.Context, _ *CallInfo) context.Context      { return ctx }
+func (nopObserver) StartAttempt(*http.Request, int)                                 {}
+func (nopObserver) EndAttempt(*http.Request, *http.Response, error)                 {}
+func (nopObserver) EndCall(context.Context, *CallInfo, *option.ResponseInfo, error) {}
+
+func TestClientOptionsDefaults(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	withDefaults := func(set func(*ClientOptions)) ClientOptions {
+		opts := DefaultClientOptions()
+		set(&opts)
+		return opts
+	}
+	cfg := &Config{Token: "test-token", BaseURL: "https://api.example.com"}
+	newWithConfig := func(opts ...ClientOptions) *Client {
+		client, err := NewClientWithConfig(cfg, opts...)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return client
+	}
+
+	tests := []struct {
+		name     string
+		client   *Client
+		attempts int
+		timeout  time.Duration
+	}{
+		{"no options", NewClient("https://api.example.com", "test-token"), 4, 30 * time.Second},
+		{"defaults with logger", NewClient("https://api.example.com", "test-token", withDefaults(func(o *ClientOptions) { o.Logger = logger })), 4, 30 * time.Second},
+		{"defaults with observer", NewClient("https://api.example.com", "test-token", withDefaults(func(o *ClientOptions) { o.Observer = nopObserver{} })), 4, 30 * time.Second},
+		{"config without options", newWithConfig(), 4, 30 * time.Second},
+		{"config with defaults and logger", newWithConfig(withDefaults(func(o *ClientOptions) { o.Logger = logger })), 4, 30 * time.Second},
+		{"zero retries and timeout", NewClient("https://api.example.com", "test-token", ClientOptions{MaxRetries: 0, Timeout: 5 * time.Second}), 1, 5 * time.Second},
+		{"logger only", NewClient("https://api.example.com", "test-token", ClientOptions{Logger: logger}), 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.client.retry.maxAttempts(); got != tt.attempts {
+				t.Errorf("Expected %d attempts, got %d", tt.attempts, got)
+			}
+			if got := tt.client.httpClient.Timeout; got != tt.timeout {
+				t.Errorf("Expected timeout %v, got %v", tt.timeout, got)
+			}
+		})
+	}
+
+	retries := 0
+	cfg.MaxRetries = &retries
+	if got := newWithConfig().retry.maxAttempts(); got != 1 {
+		t.Errorf("Expected max_retries 0 in the config to disable retries, got %d attempts", got)
+	}
+}
@@ -1,6 +1,35 @@
 package errors
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for use with errors.Is. Every error returned by the client
+// for an unsuccessful API response matches the sentinel for its status code.
+var (
+	ErrAuthentication  = errors.New("authentication failed")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrValidation      = errors.New("validation failed")
+	ErrRateLimited     = errors.New("rate limit exceeded")
+	ErrServer          = errors.New("server error")
+	ErrTransport       = errors.New("transport error")
+	ErrDecode          = errors.New("decode error")
+	ErrCircuitOpen     = errors.New("circuit open")
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+// IsRetryable reports whether err, or any error it wraps, is worth retrying.
+func IsRetryable(err error) bool {
+	var r interface{ IsRetryable() bool }
+	return errors.As(err, &r) && r.IsRetryable()
+}
 
 // APIError represents an error from the RelayWarden API.
 type APIError struct {
@@ -9,12 +38,44 @@ type APIError struct {
 	ErrorCode string
 	RequestID string
 	Details   []ValidationError
+	// IdempotencyKey is the Idempotency-Key sent with the failed request, if any.
+	// Reuse it when retrying the call to avoid duplicate side effects.
+	IdempotencyKey string
 }
 
 func (e *APIError) Error() string {
 	return fmt.Sprintf("API error (%d): %s [Request ID: %s]", e.Code, e.Message, e.RequestID)
 }
 
+// Is reports whether target is the sentinel error for the status code.
+func (e *APIError) Is(target error) bool {
+	switch e.Code {
+	case http.StatusUnauthorized:
+		return target == ErrAuthentication
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return target == ErrConflict
+	case http.StatusUnprocessableEntity:
+		return target == ErrValidation
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return e.Code >= 500 && target == ErrServer
+}
+
+// IsRetryable reports whether the request may succeed if retried.
+func (e *APIError) IsRetryable() bool {
+	switch e.Code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
 // ValidationError represents a validation error detail.
 type ValidationError struct {
 	Field   string
@@ -30,6 +91,50 @@ func (e *AuthenticationError) Error() string {
 	return fmt.Sprintf("Authentication failed: %s [Request ID: %s]", e.Message, e.RequestID)
 }
 
+func (e *AuthenticationError) Unwrap() error {
+	return e.APIError
+}
+
+// ForbiddenError represents a request the token is not allowed to make.
+type ForbiddenError struct {
+	*APIError
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("Forbidden: %s [Request ID: %s]", e.Message, e.RequestID)
+}
+
+func (e *ForbiddenError) Unwrap() error {
+	return e.APIError
+}
+
+// NotFoundError represents a request for a resource that does not exist.
+type NotFoundError struct {
+	*APIError
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("Not found: %s [Request ID: %s]", e.Message, e.RequestID)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.APIError
+}
+
+// ConflictError represents a request that conflicts with the current state of
+// a resource, including failed preconditions.
+type ConflictError struct {
+	*APIError
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("Conflict: %s [Request ID: %s]", e.Message, e.RequestID)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.APIError
+}
+
 // RateLimitError represents a rate limit error.
 type RateLimitError struct {
 	*APIError
@@ -41,6 +146,10 @@ func (e *RateLimitError) Error() string {
 		e.Message, e.RetryAfter, e.RequestID)
 }
 
+func (e *RateLimitError) Unwrap() error {
+	return e.APIError
+}
+
 // ValidationError represents validation errors.
 type ValidationErrorResponse struct {
 	*APIError
@@ -49,3 +158,159 @@ type ValidationErrorResponse struct {
 func (e *ValidationErrorResponse) Error() string {
 	return fmt.Sprintf("Validation failed: %s [Request ID: %s]", e.Message, e.RequestID)
 }
+
+func (e *ValidationErrorResponse) Unwrap() error {
+	return e.APIError
+}
+
+// ServerError represents a 5xx response from the API.
+type ServerError struct {
+	*APIError
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("Server error (%d): %s [Request ID: %s]", e.Code, e.Message, e.RequestID)
+}
+
+func (e *ServerError) Unwrap() error {
+	return e.APIError
+}
+
+// TransportError represents a request that failed before a response was received.
+type TransportError struct {
+	Err error
+	// IdempotencyKey is the Idempotency-Key sent with the failed request, if any.
+	// Reuse it when retrying the call to avoid duplicate side effects.
+	IdempotencyKey string
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("request failed: %v", e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrTransport.
+func (e *TransportError) Is(target error) bool {
+	return target == ErrTransport
+}
+
+// IsRetryable reports whether the request may succeed if retried. Requests
+// aborted by context cancellation are not retryable.
+func (e *TransportError) IsRetryable() bool {
+	return !errors.Is(e.Err, context.Canceled) && !errors.Is(e.Err, context.DeadlineExceeded)
+}
+
+// DecodeError represents a response body that could not be decoded.
+type DecodeError struct {
+	Err  error
+	Body []byte
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to unmarshal response: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrDecode.
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
+
+// IsRetryable reports false, as decoding the same response again will fail.
+func (e *DecodeError) IsRetryable() bool {
+	return false
+}
+
+// InvalidMessageError is returned when a message fails validation before it
+// is sent. Details uses the same field names as the API's 422 responses, and
+// the error matches ErrValidation.
+type InvalidMessageError struct {
+	Details []ValidationError
+}
+
+func (e *InvalidMessageError) Error() string {
+	problems := make([]string, len(e.Details))
+	for i, d := range e.Details {
+		problems[i] = d.Field + ": " + d.Message
+	}
+	return "invalid message: " + strings.Join(problems, "; ")
+}
+
+// Is reports whether target is ErrValidation.
+func (e *InvalidMessageError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// IsRetryable reports false, as the same message fails validation again.
+func (e *InvalidMessageError) IsRetryable() bool {
+	return false
+}
+
+// InvalidArgumentError is returned without sending the request when a method
+// argument cannot be used, such as an empty ID.
+type InvalidArgumentError struct {
+	// Name is the name of the invalid argument.
+	Name string
+	// Value is the rejected value.
+	Value string
+	// Reason describes why the value was rejected.
+	Reason string
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return fmt.Sprintf("invalid argument %s %q: %s", e.Name, e.Value, e.Reason)
+}
+
+// Is reports whether target is ErrInvalidArgument.
+func (e *InvalidArgumentError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// IsRetryable reports false, as the same arguments are rejected again.
+func (e *InvalidArgumentError) IsRetryable() bool {
+	return false
+}
+
+// CircuitOpenError is returned without sending the request when the client's
+// circuit breaker is open for the endpoint group of the call.
+type CircuitOpenError struct {
+	// Group is the endpoint group whose circuit is open.
+	Group string
+	// Until is when the circuit lets a trial request through again.
+	Until time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit open for %s endpoints until %s", e.Group, e.Until.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrCircuitOpen.
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// IsRetryable reports false: the request was not sent, and retrying before
+// Until fails immediately again.
+func (e *CircuitOpenError) IsRetryable() bool {
+	return false
+}
+
+// ConfigError describes an invalid client configuration, listing every
+// problem found so they can all be fixed at once.
+type ConfigError struct {
+	// Source is where the configuration was loaded from, such as a file path
+	// and profile or "environment".
+	Source string
+	// Problems describes each invalid or missing setting.
+	Problems []string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("invalid configuration from %s: %s", e.Source, strings.Join(e.Problems, "; "))
+}
@@ -0,0 +1,77 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestSentinels(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		sentinel error
+	}{
+		{"authentication", &AuthenticationError{APIError: &APIError{Code: 401}}, ErrAuthentication},
+		{"forbidden", &ForbiddenError{APIError: &APIError{Code: 403}}, ErrForbidden},
+		{"not found", &NotFoundError{APIError: &APIError{Code: 404}}, ErrNotFound},
+		{"conflict", &ConflictError{APIError: &APIError{Code: 409}}, ErrConflict},
+		{"precondition failed", &ConflictError{APIError: &APIError{Code: 412}}, ErrConflict},
+		{"validation", &ValidationErrorResponse{APIError: &APIError{Code: 422}}, ErrValidation},
+		{"rate limit", &RateLimitError{APIError: &APIError{Code: 429}}, ErrRateLimited},
+		{"server", &ServerError{APIError: &APIError{Code: 503}}, ErrServer},
+		{"bare API error", &APIError{Code: 404}, ErrNotFound},
+		{"transport", &TransportError{Err: io.EOF}, ErrTransport},
+		{"decode", &DecodeError{Err: io.ErrUnexpectedEOF}, ErrDecode},
+		{"invalid message", &InvalidMessageError{Details: []ValidationError{{Field: "to", Message: "is required"}}}, ErrValidation},
+		{"invalid argument", &InvalidArgumentError{Name: "id", Reason: "must not be empty"}, ErrInvalidArgument},
+		{"wrapped", fmt.Errorf("sending welcome email: %w", &NotFoundError{APIError: &APIError{Code: 404}}), ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.sentinel) {
+				t.Errorf("Expected errors.Is(%T, %v) to be true", tt.err, tt.sentinel)
+			}
+			if errors.Is(tt.err, ErrConflict) != (tt.sentinel == ErrConflict) {
+				t.Errorf("Expected %T to match only its own sentinel", tt.err)
+			}
+		})
+	}
+}
+
+func TestAsAPIError(t *testing.T) {
+	var err error = &AuthenticationError{APIError: &APIError{Code: 401, RequestID: "req-123"}}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatal("Expected AuthenticationError to match *APIError via errors.As")
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("Expected request ID 'req-123', got %q", apiErr.RequestID)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rate limit", &RateLimitError{APIError: &APIError{Code: 429}}, true},
+		{"bad gateway", &ServerError{APIError: &APIError{Code: 502}}, true},
+		{"internal server error", &ServerError{APIError: &APIError{Code: 500}}, false},
+		{"not found", &NotFoundError{APIError: &APIError{Code: 404}}, false},
+		{"transport", &TransportError{Err: io.EOF}, true},
+		{"canceled", &TransportError{Err: fmt.Errorf("dial: %w", context.Canceled)}, false},
+		{"decode", &DecodeError{Err: io.EOF}, false},
+		{"plain", io.EOF, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
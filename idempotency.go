@@ -0,0 +1,58 @@
+package relaywarden
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// IdempotencyKeyMode controls how Idempotency-Key headers are generated for
+// POST and PATCH requests that don't already carry one.
+type IdempotencyKeyMode int
+
+const (
+	// IdempotencyKeyRandom generates a random UUIDv7 for every call. This is the default.
+	IdempotencyKeyRandom IdempotencyKeyMode = iota
+	// IdempotencyKeyHash derives the key from a SHA-256 hash of the method, path
+	// and body, so identical calls share a key even across processes.
+	IdempotencyKeyHash
+	// IdempotencyKeyOff disables automatic idempotency keys.
+	IdempotencyKeyOff
+)
+
+// idempotencyKey returns the key to send with a request, or "" if the method
+// is not mutating or automatic keys are disabled.
+func (m IdempotencyKeyMode) idempotencyKey(method, path string, body []byte) (string, error) {
+	if method != "POST" && method != "PATCH" {
+		return "", nil
+	}
+	switch m {
+	case IdempotencyKeyRandom:
+		return newUUIDv7()
+	case IdempotencyKeyHash:
+		h := sha256.New()
+		h.Write([]byte(method + " " + path + "\n"))
+		h.Write(body)
+		return hex.EncodeToString(h.Sum(nil)), nil
+	default:
+		return "", nil
+	}
+}
+
+// newUUIDv7 returns a new time-ordered UUID as described in RFC 9562.
+func newUUIDv7() (string, error) {
+	var u [16]byte
+	if _, err := rand.Read(u[6:]); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(time.Now().UnixMilli()))
+	copy(u[:6], ts[2:])
+	u[6] = 0x70 | (u[6] & 0x0f)
+	u[8] = 0x80 | (u[8] & 0x3f)
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16]), nil
+}
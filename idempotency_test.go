@@ -0,0 +1,156 @@
+package relaywarden
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+var uuidv7Pattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// keyRecorder returns a server that records Idempotency-Key headers and fails
+// the first failures requests with a 503.
+func keyRecorder(t *testing.T, failures int) (*httptest.Server, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		n := len(keys)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if n <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":{"code":"unavailable","message":"Service unavailable"}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), keys...)
+	}
+}
+
+func TestIdempotencyKeyReusedAcrossRetries(t *testing.T) {
+	server, keys := keyRecorder(t, 1)
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	})
+
+	if _, err := client.Templates.Create(context.Background(), map[string]interface{}{"name": "Welcome"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	got := keys()
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", len(got))
+	}
+	if !uuidv7Pattern.MatchString(got[0]) {
+		t.Errorf("Expected a UUIDv7 idempotency key, got %q", got[0])
+	}
+	if got[1] != got[0] {
+		t.Errorf("Expected retry to reuse key %q, got %q", got[0], got[1])
+	}
+}
+
+func TestIdempotencyKeyModes(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   IdempotencyKeyMode
+		method string
+		check  func(t *testing.T, first, second string)
+	}{
+		{"random keys differ per call", IdempotencyKeyRandom, "POST", func(t *testing.T, first, second string) {
+			if first == "" || first == second {
+				t.Errorf("Expected distinct keys, got %q and %q", first, second)
+			}
+		}},
+		{"hashed keys match for identical calls", IdempotencyKeyHash, "PATCH", func(t *testing.T, first, second string) {
+			if first == "" || first != second {
+				t.Errorf("Expected identical keys, got %q and %q", first, second)
+			}
+		}},
+		{"off sends no key", IdempotencyKeyOff, "POST", func(t *testing.T, first, second string) {
+			if first != "" || second != "" {
+				t.Errorf("Expected no keys, got %q and %q", first, second)
+			}
+		}},
+		{"GET sends no key", IdempotencyKeyRandom, "GET", func(t *testing.T, first, second string) {
+			if first != "" || second != "" {
+				t.Errorf("Expected no keys, got %q and %q", first, second)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, keys := keyRecorder(t, 0)
+			client := NewClient(server.URL, "test-token", ClientOptions{IdempotencyKeys: tt.mode})
+			for i := 0; i < 2; i++ {
+				var body interface{}
+				if tt.method != "GET" {
+					body = map[string]interface{}{"email": "user@example.com"}
+				}
+				if _, err := client.request(context.Background(), tt.method, "/suppressions", body, nil); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+			got := keys()
+			tt.check(t, got[0], got[1])
+		})
+	}
+}
+
+func TestIdempotencyKeyCallerProvided(t *testing.T) {
+	server, keys := keyRecorder(t, 0)
+	client := NewClient(server.URL, "test-token")
+
+	if _, err := client.Messages.Send(context.Background(), map[string]interface{}{}, "my-key"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := keys(); got[0] != "my-key" {
+		t.Errorf("Expected caller key 'my-key', got %q", got[0])
+	}
+}
+
+func TestIdempotencyKeyOnError(t *testing.T) {
+	server, keys := keyRecorder(t, 2)
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 1},
+	})
+
+	_, err := client.ServiceAccounts.CreateToken(context.Background(), "sa-1", map[string]interface{}{"name": "ci"})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	key := keys()[0]
+
+	var apiErr *errors.APIError
+	if !stderrors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %T", err)
+	}
+	if apiErr.IdempotencyKey != key {
+		t.Errorf("Expected error to expose key %q, got %q", key, apiErr.IdempotencyKey)
+	}
+
+	unreachable := NewClient("http://127.0.0.1:1", "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 1},
+	})
+	_, err = unreachable.Suppressions.Import(context.Background(), map[string]interface{}{})
+	transportErr, ok := err.(*errors.TransportError)
+	if !ok {
+		t.Fatalf("Expected TransportError, got %T", err)
+	}
+	if !uuidv7Pattern.MatchString(transportErr.IdempotencyKey) {
+		t.Errorf("Expected transport error to expose a UUIDv7 key, got %q", transportErr.IdempotencyKey)
+	}
+}
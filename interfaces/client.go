@@ -2,15 +2,19 @@ package interfaces
 
 import (
 	"context"
+	"net/url"
+
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Client defines the interface for making HTTP requests.
 // This allows resources to use the client without creating import cycles.
 type Client interface {
-	Get(ctx context.Context, path string, query map[string]string) (map[string]interface{}, error)
-	Post(ctx context.Context, path string, body interface{}, headers map[string]string) (map[string]interface{}, error)
-	Patch(ctx context.Context, path string, body interface{}) (map[string]interface{}, error)
-	Delete(ctx context.Context, path string) error
+	Get(ctx context.Context, path string, query map[string]string, opts ...option.RequestOption) (map[string]interface{}, error)
+	Post(ctx context.Context, path string, body interface{}, headers map[string]string, opts ...option.RequestOption) (map[string]interface{}, error)
+	Patch(ctx context.Context, path string, body interface{}, opts ...option.RequestOption) (map[string]interface{}, error)
+	Delete(ctx context.Context, path string, opts ...option.RequestOption) error
+	Do(ctx context.Context, method, path string, query url.Values, body interface{}, headers map[string]string, out interface{}, opts ...option.RequestOption) error
 	SetProjectID(projectID string)
 	GetProjectID() *string
 	SetTeamID(teamID string)
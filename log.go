@@ -0,0 +1,202 @@
+package relaywarden
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogOptions controls what the client logs when ClientOptions.Logger is set.
+// Bearer tokens, recipient addresses and message content are always redacted.
+type LogOptions struct {
+	// SuccessLevel is the level of successful attempts. Defaults to
+	// slog.LevelDebug.
+	SuccessLevel slog.Leveler
+	// RetryLevel is the level of failed attempts that will be retried.
+	// Defaults to slog.LevelWarn.
+	RetryLevel slog.Leveler
+	// ErrorLevel is the level of failed attempts that fail the call.
+	// Defaults to slog.LevelError.
+	ErrorLevel slog.Leveler
+	// DumpCurl logs an equivalent curl command for every attempt at
+	// slog.LevelDebug, with the token and message content redacted.
+	DumpCurl bool
+}
+
+const redacted = "[REDACTED]"
+
+// emailPattern matches email addresses, including URL-escaped ones in paths.
+var emailPattern = regexp.MustCompile(`[^\s/?&=:;,"'<>()\[\]{}]+(?:@|%40)[^\s/?&=:;,"'<>()\[\]{}]+`)
+
+// recipientKeys are JSON fields whose whole value is redacted in request
+// bodies, as they hold recipients and their display names.
+var recipientKeys = map[string]bool{
+	"to": true, "cc": true, "bcc": true, "reply_to": true,
+	"recipient": true, "recipients": true,
+}
+
+// contentKeys are JSON fields holding message content. Template variables
+// and metadata often hold personal data such as names and reset links.
+var contentKeys = map[string]bool{
+	"html": true, "text": true, "content": true, "raw": true,
+	"subject": true, "variables": true, "metadata": true,
+}
+
+// attemptLog describes a single attempt for logging.
+type attemptLog struct {
+	method    string
+	path      string
+	operation string
+	region    string
+	attempt   int
+	duration  time.Duration
+	resp      *http.Response
+	body      []byte
+	retrying  bool
+	failover  bool
+	retryIn   time.Duration
+}
+
+// logAttempt logs the outcome of an attempt. If a.retrying is set, the
+// attempt failed with err and will be retried after a.retryIn.
+func (c *client) logAttempt(ctx context.Context, a *attemptLog, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	level, msg := levelOr(c.logOptions.SuccessLevel, slog.LevelDebug), "relaywarden request"
+	switch {
+	case err != nil && a.retrying:
+		level, msg = levelOr(c.logOptions.RetryLevel, slog.LevelWarn), "relaywarden request failed, retrying"
+	case err != nil:
+		level, msg = levelOr(c.logOptions.ErrorLevel, slog.LevelError), "relaywarden request failed"
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", a.method),
+		slog.String("path", redactText(a.path)),
+		slog.Int("attempt", a.attempt),
+		slog.Duration("duration", a.duration),
+	}
+	if a.operation != "" {
+		attrs = append(attrs, slog.String("operation", a.operation))
+	}
+	if a.region != "" {
+		attrs = append(attrs, slog.String("region", a.region))
+	}
+	if a.resp != nil {
+		attrs = append(attrs, slog.Int("status", a.resp.StatusCode))
+		if requestID := responseRequestID(a.resp, a.body); requestID != "" {
+			attrs = append(attrs, slog.String("request_id", requestID))
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redactText(err.Error())))
+	}
+	if a.retrying {
+		reason := "transport error"
+		if a.resp != nil {
+			reason = "status " + strconv.Itoa(a.resp.StatusCode)
+		}
+		if a.failover {
+			reason += ", failing over"
+		}
+		attrs = append(attrs, slog.String("retry_reason", reason), slog.Duration("retry_in", a.retryIn))
+	}
+	c.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// logCurl logs a curl command equivalent to req when DumpCurl is enabled.
+func (c *client) logCurl(ctx context.Context, req *http.Request, body []byte) {
+	if c.logger == nil || !c.logOptions.DumpCurl || !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "relaywarden request as curl",
+		slog.String("curl", curlCommand(req, body)))
+}
+
+// levelOr returns l's level, or def if l is nil.
+func levelOr(l slog.Leveler, def slog.Level) slog.Level {
+	if l == nil {
+		return def
+	}
+	return l.Level()
+}
+
+// curlCommand returns a curl command equivalent to req with secrets and
+// message content redacted.
+func curlCommand(req *http.Request, body []byte) string {
+	var b strings.Builder
+	b.WriteString("curl -X " + req.Method + " " + shellQuote(redactText(req.URL.String())))
+
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		for _, v := range req.Header[k] {
+			if k == "Authorization" {
+				v = "Bearer " + redacted
+			}
+			b.WriteString(" -H " + shellQuote(k+": "+redactText(v)))
+		}
+	}
+	if len(body) > 0 {
+		b.WriteString(" --data-raw " + shellQuote(string(redactBody(body))))
+	}
+	return b.String()
+}
+
+// shellQuote quotes s for use as a single POSIX shell word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// redactText replaces email addresses in s.
+func redactText(s string) string {
+	return emailPattern.ReplaceAllString(s, redacted)
+}
+
+// redactBody redacts recipients, message content and email addresses from a
+// JSON request body. Bodies that are not JSON are redacted entirely.
+func redactBody(body []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return []byte(redacted)
+	}
+	out, err := json.Marshal(redactValue("", v))
+	if err != nil {
+		return []byte(redacted)
+	}
+	return out
+}
+
+// redactValue redacts v, the value of the JSON field key, in place.
+func redactValue(key string, v interface{}) interface{} {
+	if v != nil && (recipientKeys[key] || contentKeys[key]) {
+		return redacted
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = redactValue(k, e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(key, e)
+		}
+	case string:
+		return redactText(v)
+	}
+	return v
+}
@@ -0,0 +1,125 @@
+package relaywarden
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/models"
+)
+
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Expected JSON log record, got %q", line)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestLoggerAttempts(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, DisableJitter: true},
+		Logger:      slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		LogOptions:  LogOptions{SuccessLevel: slog.LevelInfo},
+	})
+	if err := client.Suppressions.Delete(context.Background(), "user@example.com"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	records := decodeLogs(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d: %s", len(records), buf.String())
+	}
+	retry, success := records[0], records[1]
+	if retry["level"] != "WARN" || retry["retry_reason"] != "status 503" || retry["status"] != float64(503) {
+		t.Errorf("Expected retried attempt at WARN with reason, got %v", retry)
+	}
+	if success["level"] != "INFO" || success["request_id"] != "req_123" || success["attempt"] != float64(2) {
+		t.Errorf("Expected successful attempt at INFO with request ID, got %v", success)
+	}
+	if success["operation"] != "Suppressions.Delete" {
+		t.Errorf("Expected operation to be logged, got %v", success["operation"])
+	}
+	if strings.Contains(buf.String(), "user@example.com") {
+		t.Errorf("Expected recipient address to be redacted, got %s", buf.String())
+	}
+}
+
+func TestLoggerCurlRedaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message":"Invalid recipient jane@example.com"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient(server.URL, "secret-token", ClientOptions{
+		Logger:     slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		LogOptions: LogOptions{DumpCurl: true},
+	})
+	_, err := client.Messages.SendMessage(context.Background(), &models.SendMessageRequest{
+		From:    models.Address{Email: "sender@example.com"},
+		To:      []models.Address{{Email: "jane@example.com", Name: "Jane Doe"}},
+		Subject: "Your order",
+		HTML:    "<p>Secret order details</p>",
+	}, "")
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	_, err = client.Messages.SendMessage(context.Background(), &models.SendMessageRequest{
+		From:       models.Address{Email: "sender@example.com"},
+		To:         []models.Address{{Email: "jane@example.com"}},
+		TemplateID: "password-reset",
+		Variables:  map[string]interface{}{"name": "Jane", "reset_url": "https://app.example/reset?token=s3cr3t-reset-token"},
+		Metadata:   map[string]string{"account": "acct-private-42"},
+	}, "")
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"secret-token", "jane@example.com", "sender@example.com", "Jane Doe", "Secret order details", "Your order", "s3cr3t-reset-token", "acct-private-42"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected %q to be redacted, got %s", secret, out)
+		}
+	}
+
+	records := decodeLogs(t, &buf)
+	if len(records) != 4 {
+		t.Fatalf("Expected curl and attempt records for 2 calls, got %d", len(records))
+	}
+	curl, _ := records[0]["curl"].(string)
+	if !strings.HasPrefix(curl, "curl -X POST '"+server.URL+"/messages'") ||
+		!strings.Contains(curl, "'Authorization: Bearer [REDACTED]'") ||
+		!strings.Contains(curl, `"subject":"[REDACTED]"`) {
+		t.Errorf("Expected redacted curl command, got %s", curl)
+	}
+	if records[1]["level"] != "ERROR" {
+		t.Errorf("Expected failed call at ERROR, got %v", records[1]["level"])
+	}
+}
@@ -0,0 +1,28 @@
+package relaywarden
+
+import "net/http"
+
+// Handler sends a single HTTP request attempt and returns its response.
+// Middleware may inspect or modify the request before calling the next
+// Handler, and inspect the response or error it returns.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler with additional behavior such as authentication,
+// tracing, logging or header injection.
+//
+// Middleware runs once per attempt, so a request that is retried passes
+// through the chain again with a freshly built *http.Request. A Middleware
+// that returns a response without calling next must set a readable Body.
+type Middleware func(next Handler) Handler
+
+// chain wraps base with middleware so the first element is the outermost
+// handler and sees the request first.
+func chain(base Handler, middleware []Middleware) Handler {
+	h := base
+	for i := len(middleware) - 1; i >= 0; i-- {
+		if middleware[i] != nil {
+			h = middleware[i](h)
+		}
+	}
+	return h
+}
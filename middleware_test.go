@@ -0,0 +1,131 @@
+package relaywarden
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				req.Header.Add("X-Middleware", name)
+				resp, err := next(req)
+				if err == nil {
+					calls = append(calls, name+" response "+resp.Status)
+				}
+				return resp, err
+			}
+		}
+	}
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		Middleware: []Middleware{trace("outer"), trace("inner")},
+	})
+	if _, err := client.Get(context.Background(), "/identity", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := []string{"outer request", "inner request", "inner response 200 OK", "outer response 200 OK"}
+	if len(calls) != len(want) {
+		t.Fatalf("Expected calls %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Expected calls %v, got %v", want, calls)
+			break
+		}
+	}
+	if h := got.Header.Values("X-Middleware"); len(h) != 2 || h[0] != "outer" || h[1] != "inner" {
+		t.Errorf("Expected middleware headers to reach the server in order, got %v", h)
+	}
+}
+
+func TestMiddlewareRunsPerAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	attempts := 0
+	chaos := func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return nil, stderrors.New("injected failure")
+			}
+			return next(req)
+		}
+	}
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, DisableJitter: true},
+		Middleware:  []Middleware{chaos},
+	})
+	if _, err := client.Get(context.Background(), "/identity", nil); err != nil {
+		t.Fatalf("Expected retry to recover from injected failure, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected middleware to run for each of 2 attempts, got %d", attempts)
+	}
+
+	client = NewClient(server.URL, "test-token", ClientOptions{Middleware: []Middleware{chaos}})
+	attempts = 0
+	_, err := client.Get(context.Background(), "/identity", nil)
+	if !stderrors.Is(err, errors.ErrTransport) {
+		t.Errorf("Expected middleware error to surface as a transport error, got %v", err)
+	}
+}
+
+func TestTransportOption(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	used := 0
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		used++
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	client := NewClient(server.URL, "test-token", ClientOptions{Transport: transport})
+	if _, err := client.Get(context.Background(), "/identity", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	httpClient := &http.Client{Transport: transport}
+	client = NewClient(server.URL, "test-token", ClientOptions{HTTPClient: httpClient})
+	if client.httpClient != httpClient {
+		t.Error("Expected the provided HTTP client to be used")
+	}
+	if _, err := client.Get(context.Background(), "/identity", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if used != 2 {
+		t.Errorf("Expected custom transport to be used twice, got %d", used)
+	}
+}
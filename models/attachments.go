@@ -0,0 +1,141 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Content dispositions of an attachment.
+const (
+	DispositionAttachment = "attachment"
+	DispositionInline     = "inline"
+)
+
+// Attachment is a file attached to a message.
+type Attachment struct {
+	Filename string `json:"filename"`
+	// Content is the base64-encoded content.
+	Content     string `json:"content"`
+	ContentType string `json:"content_type,omitempty"`
+	// Disposition is DispositionAttachment or DispositionInline.
+	Disposition string `json:"disposition,omitempty"`
+	// ContentID identifies an inline attachment, which the HTML body
+	// references as "cid:<ContentID>".
+	ContentID string `json:"content_id,omitempty"`
+
+	// size is the size of the content before encoding.
+	size int64
+}
+
+// AddAttachment attaches the content of r as name. The content is read and
+// base64-encoded immediately, buffering up to MaxAttachmentSize bytes; the
+// content type is derived from the name's extension, falling back to sniffing
+// the content.
+func (b *MessageBuilder) AddAttachment(name string, r io.Reader) *MessageBuilder {
+	return b.attach(Attachment{Filename: name, Disposition: DispositionAttachment}, r)
+}
+
+// AttachFile attaches the file at path, named after its base name. A regular
+// file larger than MaxAttachmentSize is reported by Build without being read.
+func (b *MessageBuilder) AttachFile(path string) *MessageBuilder {
+	f, err := os.Open(path)
+	if err != nil {
+		if b.err == nil {
+			b.err = fmt.Errorf("attaching %s: %w", path, err)
+		}
+		return b
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err == nil && info.Mode().IsRegular() && info.Size() > MaxAttachmentSize {
+		if b.err == nil {
+			b.req.Attachments = append(b.req.Attachments, Attachment{
+				Filename:    filepath.Base(path),
+				Disposition: DispositionAttachment,
+				size:        info.Size(),
+			})
+		}
+		return b
+	}
+	return b.AddAttachment(filepath.Base(path), f)
+}
+
+// AddInline attaches the content of r as an inline part with content ID cid,
+// for images embedded in the HTML body with <img src="cid:...">.
+func (b *MessageBuilder) AddInline(cid string, r io.Reader) *MessageBuilder {
+	return b.attach(Attachment{Filename: cid, Disposition: DispositionInline, ContentID: cid}, r)
+}
+
+func (b *MessageBuilder) attach(a Attachment, r io.Reader) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := a.encode(r); err != nil {
+		b.err = fmt.Errorf("attaching %s: %w", a.Filename, err)
+		return b
+	}
+	b.req.Attachments = append(b.req.Attachments, a)
+	return b
+}
+
+// encode streams r through a base64 encoder into the attachment, reading at
+// most one byte more than MaxAttachmentSize. The encoded content is buffered
+// up to that limit, so oversized content is detected without reading it all,
+// and then dropped.
+func (a *Attachment) encode(r io.Reader) error {
+	var content strings.Builder
+	var head sniffBuffer
+	enc := base64.NewEncoder(base64.StdEncoding, &content)
+	n, err := io.Copy(enc, io.TeeReader(io.LimitReader(r, MaxAttachmentSize+1), &head))
+	if err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+
+	a.size = n
+	if n <= MaxAttachmentSize {
+		a.Content = content.String()
+	}
+	if a.ContentType == "" {
+		a.ContentType = mime.TypeByExtension(filepath.Ext(a.Filename))
+	}
+	if a.ContentType == "" {
+		a.ContentType = http.DetectContentType(head)
+	}
+	return nil
+}
+
+// sniffBuffer keeps the first 512 bytes written to it, the most
+// http.DetectContentType considers.
+type sniffBuffer []byte
+
+func (s *sniffBuffer) Write(p []byte) (int, error) {
+	if room := 512 - len(*s); room > 0 {
+		*s = append(*s, p[:min(room, len(p))]...)
+	}
+	return len(p), nil
+}
+
+func (v *validator) attachment(field string, a Attachment) {
+	if a.size > MaxAttachmentSize {
+		v.add(field, fmt.Sprintf("is larger than the maximum of %d bytes", MaxAttachmentSize))
+	}
+	if strings.TrimSpace(a.Filename) == "" {
+		v.add(field+".filename", "is required")
+	} else if hasLineBreak(a.Filename) {
+		v.add(field+".filename", "must not contain line breaks")
+	}
+	if a.Disposition == DispositionInline && a.ContentID == "" {
+		v.add(field+".content_id", "is required for inline attachments")
+	}
+	if hasLineBreak(a.ContentID) || strings.ContainsAny(a.ContentID, "<> ") {
+		v.add(field+".content_id", "must not contain line breaks, spaces or angle brackets")
+	}
+}
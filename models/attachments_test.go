@@ -0,0 +1,88 @@
+package models
+
+import (
+	"bytes"
+	"encoding/base64"
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+func TestMessageBuilderAttachments(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-1.7"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := validMessage().
+		HTML(`<img src="cid:logo">`).
+		AddAttachment("notes.txt", strings.NewReader("hello")).
+		AttachFile(path).
+		AddInline("logo", bytes.NewReader(png)).
+		Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		want    Attachment
+		content string
+	}{
+		{Attachment{Filename: "notes.txt", ContentType: "text/plain; charset=utf-8", Disposition: DispositionAttachment}, "hello"},
+		{Attachment{Filename: "report.pdf", ContentType: "application/pdf", Disposition: DispositionAttachment}, "%PDF-1.7"},
+		{Attachment{Filename: "logo", ContentType: "image/png", Disposition: DispositionInline, ContentID: "logo"}, string(png)},
+	}
+	if len(req.Attachments) != len(tests) {
+		t.Fatalf("Expected %d attachments, got %d", len(tests), len(req.Attachments))
+	}
+	for i, tt := range tests {
+		got := req.Attachments[i]
+		decoded, err := base64.StdEncoding.DecodeString(got.Content)
+		if err != nil || string(decoded) != tt.content {
+			t.Errorf("Attachment %d: expected content %q, got %q (%v)", i, tt.content, decoded, err)
+		}
+		got.Content, got.size = "", 0
+		if got != tt.want {
+			t.Errorf("Attachment %d: expected %+v, got %+v", i, tt.want, got)
+		}
+	}
+}
+
+func TestMessageBuilderAttachmentErrors(t *testing.T) {
+	_, err := validMessage().AttachFile(filepath.Join(t.TempDir(), "missing.pdf")).Build()
+	if !stderrors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected a not-exist error for a missing file, got %v", err)
+	}
+
+	// A sparse file, so its size is known from the file system.
+	path := filepath.Join(t.TempDir(), "large.iso")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(path, MaxAttachmentSize+1); err != nil {
+		t.Fatal(err)
+	}
+
+	large := bytes.NewReader(make([]byte, MaxAttachmentSize+1))
+	_, err = validMessage().
+		AddAttachment("large.bin", large).
+		AddInline("bad id", strings.NewReader("x")).
+		AttachFile(path).
+		Build()
+	var invalid *errors.InvalidMessageError
+	if !stderrors.As(err, &invalid) {
+		t.Fatalf("Expected InvalidMessageError, got %v", err)
+	}
+	fields := make(map[string]bool)
+	for _, d := range invalid.Details {
+		fields[d.Field] = true
+	}
+	if !fields["attachments.0"] || !fields["attachments.1.content_id"] || !fields["attachments.2"] {
+		t.Errorf("Expected size and content ID errors, got %v", invalid.Details)
+	}
+}
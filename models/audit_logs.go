@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// AuditLog is a record of an action taken within a team.
+type AuditLog struct {
+	ID           string                 `json:"id"`
+	Action       string                 `json:"action"`
+	ActorID      string                 `json:"actor_id,omitempty"`
+	ActorType    string                 `json:"actor_type,omitempty"`
+	ResourceType string                 `json:"resource_type,omitempty"`
+	ResourceID   string                 `json:"resource_id,omitempty"`
+	IPAddress    string                 `json:"ip_address,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
@@ -0,0 +1,277 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/mail"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+// Limits enforced by MessageBuilder before a message is sent.
+const (
+	// MaxRecipients is the maximum number of To, Cc and Bcc recipients combined.
+	MaxRecipients = 50
+	// MaxMessageSize is the maximum size in bytes of the encoded message,
+	// including base64-encoded attachments.
+	MaxMessageSize = 25 << 20
+	// MaxAttachmentSize is the maximum size in bytes of a single attachment
+	// before encoding.
+	MaxAttachmentSize = 10 << 20
+)
+
+// reservedHeaders are set through dedicated builder methods rather than Header.
+var reservedHeaders = map[string]string{
+	"From":     "From",
+	"To":       "To",
+	"Cc":       "Cc",
+	"Bcc":      "Bcc",
+	"Reply-To": "ReplyTo",
+	"Subject":  "Subject",
+}
+
+// MessageBuilder builds a SendMessageRequest, validating it before it is sent.
+// Methods may be chained; problems are collected and reported by Build:
+//
+//	req, err := models.NewMessageBuilder().
+//		From("noreply@example.com", "Example").
+//		To("user@example.com", "Jane Doe").
+//		Subject("Welcome").
+//		HTML("<h1>Welcome</h1>").
+//		Build()
+type MessageBuilder struct {
+	req SendMessageRequest
+	// err is the first error reading an attachment.
+	err error
+}
+
+// NewMessageBuilder returns an empty MessageBuilder.
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// From sets the sender. name may be empty.
+func (b *MessageBuilder) From(email, name string) *MessageBuilder {
+	b.req.From = Address{Email: email, Name: name}
+	return b
+}
+
+// To adds a recipient. name may be empty.
+func (b *MessageBuilder) To(email, name string) *MessageBuilder {
+	b.req.To = append(b.req.To, Address{Email: email, Name: name})
+	return b
+}
+
+// Cc adds a carbon copy recipient. name may be empty.
+func (b *MessageBuilder) Cc(email, name string) *MessageBuilder {
+	b.req.Cc = append(b.req.Cc, Address{Email: email, Name: name})
+	return b
+}
+
+// Bcc adds a blind carbon copy recipient. name may be empty.
+func (b *MessageBuilder) Bcc(email, name string) *MessageBuilder {
+	b.req.Bcc = append(b.req.Bcc, Address{Email: email, Name: name})
+	return b
+}
+
+// ReplyTo adds a reply-to address. name may be empty.
+func (b *MessageBuilder) ReplyTo(email, name string) *MessageBuilder {
+	b.req.ReplyTo = append(b.req.ReplyTo, Address{Email: email, Name: name})
+	return b
+}
+
+// Subject sets the subject.
+func (b *MessageBuilder) Subject(subject string) *MessageBuilder {
+	b.req.Subject = subject
+	return b
+}
+
+// HTML sets the HTML body.
+func (b *MessageBuilder) HTML(html string) *MessageBuilder {
+	b.req.HTML = html
+	return b
+}
+
+// Text sets the plain text body.
+func (b *MessageBuilder) Text(text string) *MessageBuilder {
+	b.req.Text = text
+	return b
+}
+
+// Header sets a custom header, replacing any previous value.
+func (b *MessageBuilder) Header(name, value string) *MessageBuilder {
+	if b.req.Headers == nil {
+		b.req.Headers = make(map[string]string)
+	}
+	b.req.Headers[name] = value
+	return b
+}
+
+// Tags adds tags to the message.
+func (b *MessageBuilder) Tags(tags ...string) *MessageBuilder {
+	b.req.Tags = append(b.req.Tags, tags...)
+	return b
+}
+
+// Metadata sets a metadata entry, replacing any previous value.
+func (b *MessageBuilder) Metadata(key, value string) *MessageBuilder {
+	if b.req.Metadata == nil {
+		b.req.Metadata = make(map[string]string)
+	}
+	b.req.Metadata[key] = value
+	return b
+}
+
+// Template renders the message from a stored template with the given
+// variables. A template replaces the subject and bodies.
+func (b *MessageBuilder) Template(id string, variables map[string]interface{}) *MessageBuilder {
+	b.req.TemplateID = id
+	b.req.Variables = variables
+	return b
+}
+
+// SendAt schedules the message instead of sending it immediately.
+func (b *MessageBuilder) SendAt(t time.Time) *MessageBuilder {
+	b.req.SendAt = &t
+	return b
+}
+
+// Build validates the message and returns the request. If the message is
+// invalid, it returns an *errors.InvalidMessageError listing every problem.
+func (b *MessageBuilder) Build() (*SendMessageRequest, error) {
+	// Copy the collections so later builder calls don't modify the request.
+	req := b.req
+	req.To = slices.Clone(req.To)
+	req.Cc = slices.Clone(req.Cc)
+	req.Bcc = slices.Clone(req.Bcc)
+	req.ReplyTo = slices.Clone(req.ReplyTo)
+	req.Tags = slices.Clone(req.Tags)
+	req.Headers = maps.Clone(req.Headers)
+	req.Metadata = maps.Clone(req.Metadata)
+	req.Attachments = slices.Clone(req.Attachments)
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	var v validator
+
+	v.address("from", req.From)
+	if len(req.To) == 0 {
+		v.add("to", "at least one recipient is required")
+	}
+	v.addresses("to", req.To)
+	v.addresses("cc", req.Cc)
+	v.addresses("bcc", req.Bcc)
+	v.addresses("reply_to", req.ReplyTo)
+	if n := len(req.To) + len(req.Cc) + len(req.Bcc); n > MaxRecipients {
+		v.add("to", fmt.Sprintf("has %d recipients, more than the maximum of %d", n, MaxRecipients))
+	}
+
+	if hasLineBreak(req.Subject) {
+		v.add("subject", "must not contain line breaks")
+	}
+	if req.TemplateID == "" {
+		if req.Subject == "" {
+			v.add("subject", "is required without a template")
+		}
+		if req.HTML == "" && req.Text == "" {
+			v.add("html", "html or text is required without a template")
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(req.Headers)) {
+		v.header(name, req.Headers[name])
+	}
+	for i, tag := range req.Tags {
+		if strings.TrimSpace(tag) == "" {
+			v.add(fmt.Sprintf("tags.%d", i), "must not be empty")
+		}
+	}
+	if _, ok := req.Metadata[""]; ok {
+		v.add("metadata", "keys must not be empty")
+	}
+	for i, a := range req.Attachments {
+		v.attachment(fmt.Sprintf("attachments.%d", i), a)
+	}
+
+	if len(v.details) == 0 {
+		body, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > MaxMessageSize {
+			v.add("message", fmt.Sprintf("is %d bytes, more than the maximum of %d", len(body), MaxMessageSize))
+		}
+	}
+	if len(v.details) > 0 {
+		return nil, &errors.InvalidMessageError{Details: v.details}
+	}
+	return &req, nil
+}
+
+// validator collects field-level validation errors.
+type validator struct {
+	details []errors.ValidationError
+}
+
+func (v *validator) add(field, message string) {
+	v.details = append(v.details, errors.ValidationError{Field: field, Message: message})
+}
+
+func (v *validator) addresses(field string, list []Address) {
+	for i, a := range list {
+		v.address(fmt.Sprintf("%s.%d", field, i), a)
+	}
+}
+
+func (v *validator) address(field string, a Address) {
+	switch {
+	case a.Email == "":
+		v.add(field+".email", "is required")
+	case !validEmail(a.Email):
+		v.add(field+".email", "is not a valid email address")
+	}
+	if hasLineBreak(a.Name) {
+		v.add(field+".name", "must not contain line breaks")
+	}
+}
+
+func (v *validator) header(name, value string) {
+	field := "headers." + name
+	if !validHeaderName(name) {
+		v.add(field, "is not a valid header name")
+	} else if method, ok := reservedHeaders[textproto.CanonicalMIMEHeaderKey(name)]; ok {
+		v.add(field, "must be set with "+method+" instead")
+	}
+	if hasLineBreak(value) {
+		v.add(field, "must not contain line breaks")
+	}
+}
+
+// validEmail reports whether s is a bare address, without a display name.
+func validEmail(s string) bool {
+	a, err := mail.ParseAddress(s)
+	return err == nil && a.Address == s
+}
+
+// validHeaderName reports whether name is a valid RFC 5322 field name:
+// printable ASCII other than the colon.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; c < '!' || c > '~' || c == ':' {
+			return false
+		}
+	}
+	return true
+}
+
+func hasLineBreak(s string) bool {
+	return strings.ContainsAny(s, "\r\n")
+}
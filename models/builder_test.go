@@ -0,0 +1,83 @@
+package models
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+func validMessage() *MessageBuilder {
+	return NewMessageBuilder().
+		From("noreply@example.com", "Example").
+		To("jane@example.com", "Jane Doe").
+		Subject("Welcome").
+		HTML("<h1>Welcome</h1>")
+}
+
+func TestMessageBuilder(t *testing.T) {
+	req, err := validMessage().
+		Cc("ops@example.com", "").
+		Header("X-Campaign", "spring").
+		Tags("welcome").
+		Metadata("user_id", "42").
+		Build()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if req.To[0].Name != "Jane Doe" || req.Cc[0].Email != "ops@example.com" || req.Headers["X-Campaign"] != "spring" {
+		t.Errorf("Unexpected request: %+v", req)
+	}
+
+	if _, err := NewMessageBuilder().
+		From("noreply@example.com", "").
+		To("jane@example.com", "").
+		Template("tmpl_1", map[string]interface{}{"name": "Jane"}).
+		Build(); err != nil {
+		t.Errorf("Expected a template message without subject or body to be valid, got %v", err)
+	}
+}
+
+func TestMessageBuilderValidation(t *testing.T) {
+	many := validMessage()
+	for range MaxRecipients {
+		many.Bcc("bulk@example.com", "")
+	}
+
+	tests := []struct {
+		name    string
+		builder *MessageBuilder
+		field   string
+	}{
+		{"missing recipient", NewMessageBuilder().From("a@example.com", "").Subject("Hi").Text("Hi"), "to"},
+		{"invalid address", validMessage().Cc("not-an-address", ""), "cc.0.email"},
+		{"address with display name", validMessage().To("Jane <jane@example.com>", ""), "to.1.email"},
+		{"name injection", validMessage().ReplyTo("a@example.com", "Jane\r\nBcc: evil@example.com"), "reply_to.0.name"},
+		{"subject injection", validMessage().Subject("Hi\nBcc: evil@example.com"), "subject"},
+		{"header name", validMessage().Header("X Bad:", "v"), "headers.X Bad:"},
+		{"header injection", validMessage().Header("X-Note", "a\r\nBcc: evil@example.com"), "headers.X-Note"},
+		{"reserved header", validMessage().Header("reply-to", "a@example.com"), "headers.reply-to"},
+		{"missing body", NewMessageBuilder().From("a@example.com", "").To("b@example.com", "").Subject("Hi"), "html"},
+		{"too many recipients", many, "to"},
+		{"too large", validMessage().Text(strings.Repeat("a", MaxMessageSize)), "message"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			var invalid *errors.InvalidMessageError
+			if !stderrors.As(err, &invalid) {
+				t.Fatalf("Expected InvalidMessageError, got %v", err)
+			}
+			if !stderrors.Is(err, errors.ErrValidation) {
+				t.Error("Expected the error to match ErrValidation")
+			}
+			for _, d := range invalid.Details {
+				if d.Field == tt.field {
+					return
+				}
+			}
+			t.Errorf("Expected an error for field %q, got %v", tt.field, invalid.Details)
+		})
+	}
+}
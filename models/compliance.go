@@ -0,0 +1,23 @@
+package models
+
+// RetentionPolicy contains the data retention settings for a team.
+type RetentionPolicy struct {
+	MessageContentDays  int `json:"message_content_days"`
+	MessageMetadataDays int `json:"message_metadata_days"`
+	EventDays           int `json:"event_days"`
+	AuditLogDays        int `json:"audit_log_days"`
+}
+
+// UpdateRetentionPolicyRequest is the payload for updating retention settings.
+type UpdateRetentionPolicyRequest struct {
+	MessageContentDays  *int `json:"message_content_days,omitempty"`
+	MessageMetadataDays *int `json:"message_metadata_days,omitempty"`
+	EventDays           *int `json:"event_days,omitempty"`
+	AuditLogDays        *int `json:"audit_log_days,omitempty"`
+}
+
+// ExportConfig describes the available compliance export formats.
+type ExportConfig struct {
+	Formats      []string `json:"formats"`
+	MaxRangeDays int      `json:"max_range_days,omitempty"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// Domain is a sending domain.
+type Domain struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	Status            string     `json:"status"`
+	ProductionEnabled bool       `json:"production_enabled"`
+	VerifiedAt        *time.Time `json:"verified_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// DNSRecord is a DNS record required to verify a domain.
+type DNSRecord struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Priority int    `json:"priority,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+
+// DomainCheck is the result of a single domain verification check.
+type DomainCheck struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	Message   string     `json:"message,omitempty"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
+
+// CreateDomainRequest is the payload for creating a sending domain.
+type CreateDomainRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateDomainRequest is the payload for updating a sending domain.
+type UpdateDomainRequest struct {
+	TrackOpens  *bool `json:"track_opens,omitempty"`
+	TrackClicks *bool `json:"track_clicks,omitempty"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Event is a delivery or engagement event.
+type Event struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	MessageID  string                 `json:"message_id,omitempty"`
+	Recipient  string                 `json:"recipient,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
@@ -0,0 +1,247 @@
+package models
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Filter is implemented by the typed filters accepted by list endpoints.
+// Values encodes the filter as query parameters; multi-value filters are
+// encoded as repeated "name[]" parameters and times in RFC 3339 format, UTC.
+type Filter interface {
+	Values() url.Values
+}
+
+// ListOptions controls the pagination of a list endpoint.
+type ListOptions struct {
+	// Page is the page number to return, starting at 1.
+	Page int
+	// PerPage is the number of items per page.
+	PerPage int
+	// Cursor resumes a cursor-paginated listing. It takes precedence over Page.
+	Cursor string
+}
+
+// Values encodes the pagination options as query parameters.
+func (o *ListOptions) Values() url.Values {
+	v := make(url.Values)
+	if o == nil {
+		return v
+	}
+	if o.Cursor != "" {
+		v.Set("cursor", o.Cursor)
+	} else if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	return v
+}
+
+// MessageFilter filters the messages returned by Messages.ListMessages.
+type MessageFilter struct {
+	ListOptions
+	// Status matches messages in any of the given statuses.
+	Status []string
+	// Tags matches messages carrying any of the given tags.
+	Tags       []string
+	Recipient  string
+	TemplateID string
+	// Since and Until bound the creation time of the messages. Since is
+	// inclusive and Until exclusive; zero values leave the range open.
+	Since time.Time
+	Until time.Time
+}
+
+// Values encodes the filter as query parameters.
+func (f *MessageFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "status", f.Status)
+	addAll(v, "tags", f.Tags)
+	setString(v, "recipient", f.Recipient)
+	setString(v, "template_id", f.TemplateID)
+	setTime(v, "since", f.Since)
+	setTime(v, "until", f.Until)
+	return v
+}
+
+// EventFilter filters the events returned by Events.ListEvents.
+type EventFilter struct {
+	ListOptions
+	// Types matches events of any of the given types.
+	Types     []string
+	MessageID string
+	Recipient string
+	// Since and Until bound the time the events occurred at.
+	Since time.Time
+	Until time.Time
+}
+
+// Values encodes the filter as query parameters.
+func (f *EventFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "type", f.Types)
+	setString(v, "message_id", f.MessageID)
+	setString(v, "recipient", f.Recipient)
+	setTime(v, "since", f.Since)
+	setTime(v, "until", f.Until)
+	return v
+}
+
+// AuditLogFilter filters the entries returned by AuditLogs.ListAuditLogs.
+type AuditLogFilter struct {
+	ListOptions
+	// Actions matches entries recording any of the given actions.
+	Actions      []string
+	ActorID      string
+	ResourceType string
+	// Since and Until bound the creation time of the entries.
+	Since time.Time
+	Until time.Time
+}
+
+// Values encodes the filter as query parameters.
+func (f *AuditLogFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "action", f.Actions)
+	setString(v, "actor_id", f.ActorID)
+	setString(v, "resource_type", f.ResourceType)
+	setTime(v, "since", f.Since)
+	setTime(v, "until", f.Until)
+	return v
+}
+
+// DomainFilter filters the domains returned by Domains.ListDomains.
+type DomainFilter struct {
+	ListOptions
+	// Status matches domains in any of the given verification statuses.
+	Status []string
+}
+
+// Values encodes the filter as query parameters.
+func (f *DomainFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "status", f.Status)
+	return v
+}
+
+// SenderFilter filters the senders returned by Senders.ListSenders.
+type SenderFilter struct {
+	ListOptions
+	// Status matches senders in any of the given statuses.
+	Status []string
+}
+
+// Values encodes the filter as query parameters.
+func (f *SenderFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "status", f.Status)
+	return v
+}
+
+// SuppressionFilter filters the entries returned by Suppressions.ListSuppressions.
+type SuppressionFilter struct {
+	ListOptions
+	// Reasons matches entries suppressed for any of the given reasons.
+	Reasons []string
+	Email   string
+}
+
+// Values encodes the filter as query parameters.
+func (f *SuppressionFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "reason", f.Reasons)
+	setString(v, "email", f.Email)
+	return v
+}
+
+// DeliveryFilter filters the deliveries returned by Webhooks.ListWebhookDeliveries.
+type DeliveryFilter struct {
+	ListOptions
+	// Status matches deliveries in any of the given statuses.
+	Status []string
+	// EventTypes matches deliveries of any of the given event types.
+	EventTypes []string
+	// Since and Until bound the creation time of the deliveries.
+	Since time.Time
+	Until time.Time
+}
+
+// Values encodes the filter as query parameters.
+func (f *DeliveryFilter) Values() url.Values {
+	if f == nil {
+		return make(url.Values)
+	}
+	v := f.ListOptions.Values()
+	addAll(v, "status", f.Status)
+	addAll(v, "event_type", f.EventTypes)
+	setTime(v, "since", f.Since)
+	setTime(v, "until", f.Until)
+	return v
+}
+
+// UsageFilter selects the days returned by Usage.GetDailyUsage.
+type UsageFilter struct {
+	// Since and Until bound the range of days, both inclusive. Only the
+	// date of each value, in UTC, is used.
+	Since time.Time
+	Until time.Time
+}
+
+// Values encodes the filter as query parameters.
+func (f *UsageFilter) Values() url.Values {
+	v := make(url.Values)
+	if f == nil {
+		return v
+	}
+	setDate(v, "since", f.Since)
+	setDate(v, "until", f.Until)
+	return v
+}
+
+func setString(v url.Values, key, value string) {
+	if value != "" {
+		v.Set(key, value)
+	}
+}
+
+func setTime(v url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		v.Set(key, t.UTC().Format(time.RFC3339))
+	}
+}
+
+func setDate(v url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		v.Set(key, t.UTC().Format(time.DateOnly))
+	}
+}
+
+// addAll encodes values as a repeated "key[]" parameter.
+func addAll(v url.Values, key string, values []string) {
+	for _, value := range values {
+		if value != "" {
+			v.Add(key+"[]", value)
+		}
+	}
+}
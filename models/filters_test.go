@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFilterValues(t *testing.T) {
+	since := time.Date(2024, 3, 1, 9, 30, 0, 0, time.FixedZone("CET", 3600))
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"nil", (*MessageFilter)(nil), ""},
+		{
+			"messages",
+			&MessageFilter{
+				ListOptions: ListOptions{Page: 2, PerPage: 50},
+				Status:      []string{"bounced", "failed"},
+				Recipient:   "jane+test@example.com",
+				Since:       since,
+			},
+			"page=2&per_page=50&recipient=jane%2Btest%40example.com&since=2024-03-01T08%3A30%3A00Z&status%5B%5D=bounced&status%5B%5D=failed",
+		},
+		{
+			"cursor takes precedence over page",
+			&EventFilter{ListOptions: ListOptions{Page: 3, Cursor: "abc"}, Types: []string{"delivered"}},
+			"cursor=abc&type%5B%5D=delivered",
+		},
+		{"usage dates", &UsageFilter{Since: since, Until: since.AddDate(0, 0, 6)}, "since=2024-03-01&until=2024-03-07"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Values().Encode(); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
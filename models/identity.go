@@ -0,0 +1,17 @@
+package models
+
+// Identity describes the currently authenticated user or service account.
+type Identity struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Email  string `json:"email,omitempty"`
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// Team is a team the authenticated user belongs to.
+type Team struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role,omitempty"`
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Message is an email message.
+type Message struct {
+	ID         string            `json:"id"`
+	Status     string            `json:"status"`
+	From       Address           `json:"from"`
+	To         []Address         `json:"to,omitempty"`
+	Cc         []Address         `json:"cc,omitempty"`
+	Bcc        []Address         `json:"bcc,omitempty"`
+	ReplyTo    []Address         `json:"reply_to,omitempty"`
+	Subject    string            `json:"subject"`
+	TemplateID string            `json:"template_id,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	SendAt     *time.Time        `json:"send_at,omitempty"`
+	SentAt     *time.Time        `json:"sent_at,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes a Message, accepting the "message_id" key returned
+// by the send endpoint as an alias for "id".
+func (m *Message) UnmarshalJSON(b []byte) error {
+	type message Message
+	var aux struct {
+		message
+		MessageID string `json:"message_id"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	*m = Message(aux.message)
+	if m.ID == "" {
+		m.ID = aux.MessageID
+	}
+	return nil
+}
+
+// SendMessageRequest is the payload for sending a message.
+type SendMessageRequest struct {
+	From        Address                `json:"from"`
+	To          []Address              `json:"to"`
+	Cc          []Address              `json:"cc,omitempty"`
+	Bcc         []Address              `json:"bcc,omitempty"`
+	ReplyTo     []Address              `json:"reply_to,omitempty"`
+	Subject     string                 `json:"subject,omitempty"`
+	HTML        string                 `json:"html,omitempty"`
+	Text        string                 `json:"text,omitempty"`
+	Headers     map[string]string      `json:"headers,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	Metadata    map[string]string      `json:"metadata,omitempty"`
+	TemplateID  string                 `json:"template_id,omitempty"`
+	Variables   map[string]interface{} `json:"variables,omitempty"`
+	SendAt      *time.Time             `json:"send_at,omitempty"`
+	Attachments []Attachment           `json:"attachments,omitempty"`
+}
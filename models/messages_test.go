@@ -0,0 +1,29 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"id", `{"id":"msg-1","status":"delivered"}`, "msg-1"},
+		{"message_id alias", `{"message_id":"msg-2","status":"accepted"}`, "msg-2"},
+		{"id wins over alias", `{"id":"msg-3","message_id":"msg-4"}`, "msg-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Message
+			if err := json.Unmarshal([]byte(tt.body), &m); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if m.ID != tt.want {
+				t.Errorf("Expected ID to be %q, got %q", tt.want, m.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Package models contains typed request and response models for the RelayWarden API.
+package models
+
+// Meta contains the metadata returned alongside the data of every response.
+type Meta struct {
+	RequestID   string `json:"request_id,omitempty"`
+	CurrentPage int    `json:"current_page,omitempty"`
+	PerPage     int    `json:"per_page,omitempty"`
+	Total       int    `json:"total,omitempty"`
+	LastPage    int    `json:"last_page,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+}
+
+// Response is the envelope returned by every JSON endpoint.
+type Response[T any] struct {
+	Data T    `json:"data"`
+	Meta Meta `json:"meta"`
+}
+
+// List is a single page of results returned by a list endpoint.
+type List[T any] struct {
+	Data []T  `json:"data"`
+	Meta Meta `json:"meta"`
+}
+
+// Address is an email address with an optional display name.
+type Address struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Project is a project within a team.
+type Project struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Environment string    `json:"environment"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateProjectRequest is the payload for creating a project.
+type CreateProjectRequest struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// UpdateProjectRequest is the payload for updating a project.
+type UpdateProjectRequest struct {
+	Name        string `json:"name,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
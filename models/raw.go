@@ -0,0 +1,252 @@
+package models
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// rawHeaders are headers of a raw message that are not copied to the
+// request: addressing and content headers are represented by request fields,
+// and trace headers are added again when the message is delivered. Outlook's
+// conversation headers describe the original message and are dropped too.
+var rawHeaders = []string{
+	"From", "To", "Cc", "Bcc", "Reply-To", "Subject",
+	"Date", "Message-Id", "Mime-Version",
+	"Content-Type", "Content-Transfer-Encoding", "Content-Disposition", "Content-Id",
+	"Received", "Return-Path", "Delivered-To", "Dkim-Signature", "Authentication-Results",
+	"Received-Spf", "X-Received", "X-Originating-Ip", "X-Virus-Scanned",
+	"Thread-Index", "Thread-Topic", "Accept-Language",
+}
+
+// rawHeaderPrefixes are prefixes of trace and filtering headers added by
+// mail servers such as Gmail and Exchange Online.
+var rawHeaderPrefixes = []string{
+	"Arc-", "X-Google-", "X-Gm-", "X-Ms-", "X-Microsoft-", "X-Forefront-",
+	"X-Eop", "X-Incoming", "X-Spam-", "X-Original-",
+}
+
+// skipHeader reports whether a header of a raw message is not copied to the
+// request.
+func skipHeader(name string) bool {
+	name = textproto.CanonicalMIMEHeaderKey(name)
+	if slices.Contains(rawHeaders, name) {
+		return true
+	}
+	for _, prefix := range rawHeaderPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordDecoder decodes RFC 2047 encoded words in headers, including the
+// windows-1252 words written by Outlook.
+var wordDecoder = &mime.WordDecoder{
+	CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+		data, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		text, err := decodeCharset(data, charset)
+		if err != nil {
+			return nil, err
+		}
+		return strings.NewReader(text), nil
+	},
+}
+
+// ReadMessage parses an RFC 5322 message, such as the content of an .eml
+// file, into a MessageBuilder. The text/plain and text/html parts become the
+// bodies, other parts become attachments or inline attachments, and custom
+// headers are kept. The returned builder can be modified further before
+// calling Build.
+func ReadMessage(r io.Reader) (*MessageBuilder, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading message: %w", err)
+	}
+
+	b := NewMessageBuilder()
+	if err := readAddresses(msg.Header, "From", func(a *mail.Address) { b.From(a.Address, a.Name) }); err != nil {
+		return nil, err
+	}
+	for _, field := range []struct {
+		name string
+		add  func(email, name string) *MessageBuilder
+	}{
+		{"To", b.To}, {"Cc", b.Cc}, {"Bcc", b.Bcc}, {"Reply-To", b.ReplyTo},
+	} {
+		if err := readAddresses(msg.Header, field.name, func(a *mail.Address) { field.add(a.Address, a.Name) }); err != nil {
+			return nil, err
+		}
+	}
+
+	subject, err := wordDecoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		return nil, fmt.Errorf("reading message: decoding Subject: %w", err)
+	}
+	b.Subject(subject)
+
+	for name, values := range msg.Header {
+		if skipHeader(name) {
+			continue
+		}
+		value, err := wordDecoder.DecodeHeader(strings.Join(values, ", "))
+		if err != nil {
+			value = strings.Join(values, ", ")
+		}
+		b.Header(name, value)
+	}
+
+	if err := readPart(b, textproto.MIMEHeader(msg.Header), msg.Body); err != nil {
+		return nil, fmt.Errorf("reading message: %w", err)
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b, nil
+}
+
+// readAddresses calls add for each address in the header field name.
+func readAddresses(h mail.Header, name string, add func(*mail.Address)) error {
+	if h.Get(name) == "" {
+		return nil
+	}
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	list, err := parser.ParseList(h.Get(name))
+	if err != nil {
+		return fmt.Errorf("reading message: parsing %s: %w", name, err)
+	}
+	for _, a := range list {
+		add(a)
+	}
+	return nil
+}
+
+// readPart adds a MIME part, recursing into multipart containers.
+func readPart(b *MessageBuilder, h textproto.MIMEHeader, body io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := readPart(b, part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+
+	body = decodeTransfer(h.Get("Content-Transfer-Encoding"), body)
+	disposition, dparams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+	filename := dparams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+	if decoded, err := wordDecoder.DecodeHeader(filename); err == nil {
+		filename = decoded
+	}
+	contentID := strings.Trim(h.Get("Content-Id"), "<>")
+
+	isBody := disposition != "attachment" && filename == "" && contentID == ""
+	switch {
+	case isBody && mediaType == "text/plain" && b.req.Text == "":
+		text, err := readText(body, params["charset"])
+		b.Text(text)
+		return err
+	case isBody && mediaType == "text/html" && b.req.HTML == "":
+		html, err := readText(body, params["charset"])
+		b.HTML(html)
+		return err
+	}
+
+	a := Attachment{Filename: filename, ContentType: mediaType, Disposition: DispositionAttachment}
+	if contentID != "" && disposition != "attachment" {
+		a.Disposition = DispositionInline
+		a.ContentID = contentID
+		if a.Filename == "" {
+			a.Filename = contentID
+		}
+	}
+	if a.Filename == "" {
+		a.Filename = "attachment"
+	}
+	b.attach(a, body)
+	return nil
+}
+
+// decodeTransfer decodes the Content-Transfer-Encoding of a part body.
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// readText reads a text body, converting it to UTF-8 with LF line endings.
+func readText(r io.Reader, charset string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return decodeCharset(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n")), charset)
+}
+
+// cp1252 maps the bytes 0x80 to 0x9F of windows-1252 to Unicode. Bytes that
+// windows-1252 leaves undefined map to the same code point, as in latin1.
+var cp1252 = [32]rune{
+	'\u20ac', '\u0081', '\u201a', '\u0192', '\u201e', '\u2026', '\u2020', '\u2021',
+	'\u02c6', '\u2030', '\u0160', '\u2039', '\u0152', '\u008d', '\u017d', '\u008f',
+	'\u0090', '\u2018', '\u2019', '\u201c', '\u201d', '\u2022', '\u2013', '\u2014',
+	'\u02dc', '\u2122', '\u0161', '\u203a', '\u0153', '\u009d', '\u017e', '\u0178',
+}
+
+// decodeCharset converts text in charset to UTF-8.
+func decodeCharset(data []byte, charset string) (string, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "us-ascii":
+		return string(data), nil
+	case "iso-8859-1", "latin1", "windows-1252", "cp1252":
+		// Messages labelled latin1 are often windows-1252, so both are read
+		// as windows-1252, the way browsers do. The two only differ in
+		// 0x80 to 0x9F, which are unused control codes in latin1.
+		runes := make([]rune, len(data))
+		for i, c := range data {
+			if c >= 0x80 && c < 0xa0 {
+				runes[i] = cp1252[c-0x80]
+			} else {
+				runes[i] = rune(c)
+			}
+		}
+		return string(runes), nil
+	default:
+		if utf8.Valid(data) {
+			return string(data), nil
+		}
+		return "", fmt.Errorf("unsupported charset %q", charset)
+	}
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestReadMessageGolden parses each .eml file in testdata/raw and compares
+// the resulting request with the .json golden file next to it. Run with
+// -update to regenerate the golden files.
+func TestReadMessageGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "raw", "*.eml"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("Expected .eml files in testdata/raw, got %v", err)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			f, err := os.Open(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			b, err := ReadMessage(f)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			req, err := b.Build()
+			if err != nil {
+				t.Fatalf("Expected a valid message, got %v", err)
+			}
+			got, err := json.MarshalIndent(req, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			golden := strings.TrimSuffix(file, ".eml") + ".json"
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("Request does not match %s:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestReadMessageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"no header", "not a message"},
+		{"bad address", "From: <broken\r\nTo: a@example.com\r\n\r\nHi\r\n"},
+		{"unsupported charset", "From: a@example.com\r\nTo: b@example.com\r\nContent-Type: text/plain; charset=koi8-r\r\n\r\n\xf0\xd2\xc9\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadMessage(strings.NewReader(tt.raw)); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestSkipHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		skip bool
+	}{
+		{"Received", true},
+		{"x-received", true},
+		{"X-Google-Smtp-Source", true},
+		{"X-Gm-Message-State", true},
+		{"X-MS-Exchange-Organization-SCL", true},
+		{"X-Forefront-Antispam-Report", true},
+		{"ARC-Seal", true},
+		{"List-Unsubscribe", false},
+		{"X-Campaign", false},
+		{"In-Reply-To", false},
+	}
+	for _, tt := range tests {
+		if got := skipHeader(tt.name); got != tt.skip {
+			t.Errorf("skipHeader(%q) = %v, expected %v", tt.name, got, tt.skip)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Sender is a verified sender address.
+type Sender struct {
+	ID         string     `json:"id"`
+	Email      string     `json:"email"`
+	Name       string     `json:"name,omitempty"`
+	DomainID   string     `json:"domain_id,omitempty"`
+	Status     string     `json:"status"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateSenderRequest is the payload for creating a sender address.
+type CreateSenderRequest struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
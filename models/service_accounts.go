@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// ServiceAccount is a non-human identity used to access the API.
+type ServiceAccount struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateServiceAccountRequest is the payload for creating a service account.
+type CreateServiceAccountRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Token is an API token belonging to a service account. The plain-text
+// Token value is only returned when the token is created.
+type Token struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Token      string     `json:"token,omitempty"`
+	Abilities  []string   `json:"abilities,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateTokenRequest is the payload for creating a service account token.
+type CreateTokenRequest struct {
+	Name      string     `json:"name"`
+	Abilities []string   `json:"abilities,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Suppression is a recipient that will not receive messages.
+type Suppression struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Reason    string    `json:"reason"`
+	Source    string    `json:"source,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateSuppressionRequest is the payload for adding a suppression.
+type CreateSuppressionRequest struct {
+	Email  string `json:"email"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ImportSuppressionsRequest is the payload for importing suppressions in bulk.
+type ImportSuppressionsRequest struct {
+	Suppressions []CreateSuppressionRequest `json:"suppressions"`
+}
+
+// SuppressionImport is the result of a bulk suppression import.
+type SuppressionImport struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
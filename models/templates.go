@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// Template is a reusable message template.
+type Template struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Subject        string    `json:"subject"`
+	HTML           string    `json:"html,omitempty"`
+	Text           string    `json:"text,omitempty"`
+	CurrentVersion int       `json:"current_version"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TemplateVersion is an immutable revision of a template.
+type TemplateVersion struct {
+	ID         string    `json:"id"`
+	TemplateID string    `json:"template_id"`
+	Version    int       `json:"version"`
+	Subject    string    `json:"subject"`
+	HTML       string    `json:"html,omitempty"`
+	Text       string    `json:"text,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// RenderedTemplate is the output of rendering a template.
+type RenderedTemplate struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// CreateTemplateRequest is the payload for creating a template.
+type CreateTemplateRequest struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// UpdateTemplateRequest is the payload for updating a template.
+type UpdateTemplateRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// CreateTemplateVersionRequest is the payload for creating a template version.
+type CreateTemplateVersionRequest struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// RenderTemplateRequest is the payload for rendering a template.
+type RenderTemplateRequest struct {
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// TestSendTemplateRequest is the payload for sending a test message from a template.
+type TestSendTemplateRequest struct {
+	To        []Address              `json:"to"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
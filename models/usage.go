@@ -0,0 +1,29 @@
+package models
+
+// UsageDay contains usage statistics for a single day.
+type UsageDay struct {
+	Date       string `json:"date"`
+	Sent       int    `json:"sent"`
+	Delivered  int    `json:"delivered"`
+	Bounced    int    `json:"bounced"`
+	Complained int    `json:"complained"`
+	Opened     int    `json:"opened"`
+	Clicked    int    `json:"clicked"`
+}
+
+// Limits contains usage limits and remaining quota.
+type Limits struct {
+	DailyLimit       int `json:"daily_limit"`
+	DailyUsed        int `json:"daily_used"`
+	DailyRemaining   int `json:"daily_remaining"`
+	MonthlyLimit     int `json:"monthly_limit"`
+	MonthlyUsed      int `json:"monthly_used"`
+	MonthlyRemaining int `json:"monthly_remaining"`
+	RateLimit        int `json:"rate_limit"`
+}
+
+// Diagnostics contains system health and diagnostic information.
+type Diagnostics struct {
+	Status string                 `json:"status"`
+	Checks map[string]interface{} `json:"checks,omitempty"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// WebhookEndpoint is a URL that receives event notifications.
+type WebhookEndpoint struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description,omitempty"`
+	Events      []string  `json:"events"`
+	Enabled     bool      `json:"enabled"`
+	Secret      string    `json:"secret,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Delivery is a single attempt to deliver an event to a webhook endpoint.
+type Delivery struct {
+	ID             string     `json:"id"`
+	EndpointID     string     `json:"endpoint_id"`
+	EventID        string     `json:"event_id,omitempty"`
+	EventType      string     `json:"event_type,omitempty"`
+	Status         string     `json:"status"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	Attempts       int        `json:"attempts"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// CreateWebhookEndpointRequest is the payload for creating a webhook endpoint.
+type CreateWebhookEndpointRequest struct {
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	Events      []string `json:"events"`
+}
+
+// UpdateWebhookEndpointRequest is the payload for updating a webhook endpoint.
+type UpdateWebhookEndpointRequest struct {
+	URL         string   `json:"url,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+}
@@ -0,0 +1,75 @@
+package relaywarden
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// CallInfo describes an API call for observers.
+type CallInfo struct {
+	// Method is the HTTP method of the call.
+	Method string
+	// Path is the request path relative to the base URL, without the query.
+	Path string
+	// Resource is the resource the call was made through, e.g. "Messages".
+	// It is empty for calls made directly on the client.
+	Resource string
+	// Operation is the name of the resource method, e.g. "SendMessage".
+	Operation string
+	// ProjectID is the project the call is scoped to, if any.
+	ProjectID string
+	// TeamID is the team the call is scoped to, if any.
+	TeamID string
+}
+
+// Observer is notified about every API call made by a client and about each
+// attempt of that call. Implementations must be safe for concurrent use.
+// See the otel package for an OpenTelemetry implementation.
+type Observer interface {
+	// StartCall is called before the first attempt of a call. The returned
+	// context is used for every attempt and passed to EndCall.
+	StartCall(ctx context.Context, call *CallInfo) context.Context
+	// StartAttempt is called with the request of every attempt before it is
+	// passed to the middleware chain, for example to inject trace headers.
+	StartAttempt(req *http.Request, attempt int)
+	// EndAttempt is called after every attempt with either its response or
+	// its transport error. The response body has already been read and
+	// closed; err is set if reading it failed.
+	EndAttempt(req *http.Request, resp *http.Response, err error)
+	// EndCall is called once when the call completes, with the response
+	// metadata of the final attempt and the error returned to the caller.
+	EndCall(ctx context.Context, call *CallInfo, info *option.ResponseInfo, err error)
+}
+
+// callInfo describes a call for observers. Per-call options take precedence
+// over the client scope.
+func (c *client) callInfo(method, path string, cfg *option.RequestConfig) *CallInfo {
+	call := &CallInfo{
+		Method:    method,
+		Path:      path,
+		ProjectID: cfg.ProjectID,
+		TeamID:    cfg.TeamID,
+	}
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		call.Path = path[:i]
+	}
+	if resource, op, ok := strings.Cut(cfg.Operation, "."); ok {
+		call.Resource, call.Operation = resource, op
+	} else {
+		call.Operation = cfg.Operation
+	}
+	if call.ProjectID == "" {
+		if projectID := c.projectID.Load(); projectID != nil {
+			call.ProjectID = *projectID
+		}
+	}
+	if call.TeamID == "" {
+		if teamID := c.teamID.Load(); teamID != nil {
+			call.TeamID = *teamID
+		}
+	}
+	return call
+}
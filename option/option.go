@@ -0,0 +1,147 @@
+// Package option contains per-call options accepted by every resource method.
+package option
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RequestOption configures a single API call.
+type RequestOption func(*RequestConfig)
+
+// RequestConfig holds the per-call configuration built from RequestOptions.
+type RequestConfig struct {
+	// ProjectID overrides the client's X-Project-Id header.
+	ProjectID string
+	// TeamID overrides the client's X-Team-Id header.
+	TeamID string
+	// Header contains extra headers, applied after all others.
+	Header http.Header
+	// Timeout bounds the whole call, including retries. Zero means no limit.
+	Timeout time.Duration
+	// IdempotencyKey overrides the Idempotency-Key sent with the request.
+	IdempotencyKey string
+	// Query contains extra query parameters.
+	Query url.Values
+	// Response, if set, is populated with metadata about the HTTP response.
+	Response *ResponseInfo
+	// Operation names the call for observers, e.g. "Messages.SendMessage".
+	Operation string
+}
+
+// NewRequestConfig applies opts to a new RequestConfig.
+func NewRequestConfig(opts ...RequestOption) *RequestConfig {
+	cfg := &RequestConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	return cfg
+}
+
+// WithProject scopes the call to a project, overriding the client's project ID.
+func WithProject(projectID string) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.ProjectID = projectID
+	}
+}
+
+// WithTeam scopes the call to a team, overriding the client's team ID.
+func WithTeam(teamID string) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.TeamID = teamID
+	}
+}
+
+// WithHeader sets a request header, replacing any value set by the client.
+func WithHeader(key, value string) RequestOption {
+	return func(cfg *RequestConfig) {
+		if cfg.Header == nil {
+			cfg.Header = make(http.Header)
+		}
+		cfg.Header.Set(key, value)
+	}
+}
+
+// WithTimeout bounds the whole call, including retries and the waits between them.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.Timeout = timeout
+	}
+}
+
+// WithIdempotencyKey sets the Idempotency-Key sent with the call, replacing
+// the automatically generated one.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.IdempotencyKey = key
+	}
+}
+
+// WithQuery adds a query parameter to the call.
+func WithQuery(key, value string) RequestOption {
+	return func(cfg *RequestConfig) {
+		if cfg.Query == nil {
+			cfg.Query = make(url.Values)
+		}
+		cfg.Query.Add(key, value)
+	}
+}
+
+// ResponseInfo contains metadata about the HTTP response of a call.
+type ResponseInfo struct {
+	// StatusCode is the HTTP status code of the final attempt, or 0 if no
+	// response was received.
+	StatusCode int
+	// Header contains the response headers of the final attempt.
+	Header http.Header
+	// RequestID is the API request ID, taken from the X-Request-Id header or
+	// the response meta.
+	RequestID string
+	// IdempotencyKey is the Idempotency-Key sent with the request, if any.
+	IdempotencyKey string
+	// RateLimit contains the rate limit state reported by the API.
+	RateLimit RateLimit
+	// Attempts is the number of attempts made, including retries, failovers
+	// and the re-send after a token rotation.
+	Attempts int
+	// Retries is the number of attempts made after a failure and a backoff
+	// delay, as counted against the retry policy.
+	Retries int
+	// Failovers is the number of attempts sent to another region after a
+	// regional failure. They do not count as retries.
+	Failovers int
+	// Latency is the round-trip time of the final attempt.
+	Latency time.Duration
+	// Elapsed is the total time spent on the call, including retries and waits.
+	Elapsed time.Duration
+	// Region is the name of the region that served the final attempt, if the
+	// client is configured with regions.
+	Region string
+}
+
+// RateLimit contains the rate limit state reported in X-RateLimit-* headers.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// WithResponse populates info with metadata about the HTTP response once the
+// call returns, whether it succeeded or not.
+func WithResponse(info *ResponseInfo) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.Response = info
+	}
+}
+
+// WithOperation names the call for observers such as the otel package, e.g.
+// "Messages.SendMessage". Resource methods set it automatically; it is mainly
+// useful for calls made with relaywarden.Do.
+func WithOperation(name string) RequestOption {
+	return func(cfg *RequestConfig) {
+		cfg.Operation = name
+	}
+}
@@ -0,0 +1,237 @@
+// Package otel instruments a RelayWarden client with OpenTelemetry.
+//
+// It records a client span per API call, propagates the trace context on
+// every outgoing request, and records metrics for call latency, retries,
+// region failovers and rate-limited responses:
+//
+//	observer, err := otel.NewObserver()
+//	if err != nil {
+//		return err
+//	}
+//	client := relaywarden.NewClient(baseURL, token, relaywarden.ClientOptions{
+//		Observer: observer,
+//	})
+package otel
+
+import (
+	"context"
+	"net/http"
+
+	relaywarden "github.com/relaywarden/go-sdk"
+	"github.com/relaywarden/go-sdk/option"
+	global "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name used for the tracer and meter.
+const ScopeName = "github.com/relaywarden/go-sdk/otel"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	ResourceKey   = attribute.Key("relaywarden.resource")
+	OperationKey  = attribute.Key("relaywarden.operation")
+	ProjectIDKey  = attribute.Key("relaywarden.project_id")
+	TeamIDKey     = attribute.Key("relaywarden.team_id")
+	RequestIDKey  = attribute.Key("relaywarden.request_id")
+	RetryCountKey = attribute.Key("relaywarden.retry_count")
+	// FailoverCountKey is the number of attempts sent to another region,
+	// which are not counted as retries.
+	FailoverCountKey = attribute.Key("relaywarden.failover_count")
+	MethodKey        = attribute.Key("http.request.method")
+	StatusCodeKey    = attribute.Key("http.response.status_code")
+	PathKey          = attribute.Key("url.path")
+	RegionKey        = attribute.Key("relaywarden.region")
+)
+
+// Observer implements relaywarden.Observer using OpenTelemetry.
+type Observer struct {
+	tracer      trace.Tracer
+	propagator  propagation.TextMapPropagator
+	duration    metric.Float64Histogram
+	retries     metric.Int64Counter
+	failovers   metric.Int64Counter
+	rateLimited metric.Int64Counter
+}
+
+var _ relaywarden.Observer = (*Observer)(nil)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures an Observer.
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider. Defaults to the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the meter provider. Defaults to the global one.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context into
+// outgoing requests. Defaults to the W3C Trace Context propagator.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// NewObserver creates an Observer. It returns an error if the metric
+// instruments cannot be created.
+func NewObserver(opts ...Option) (*Observer, error) {
+	cfg := config{
+		tracerProvider: global.GetTracerProvider(),
+		meterProvider:  global.GetMeterProvider(),
+		propagator:     propagation.TraceContext{},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(ScopeName)
+	duration, err := meter.Float64Histogram("relaywarden.client.call.duration",
+		metric.WithDescription("Duration of API calls, including retries."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	retries, err := meter.Int64Counter("relaywarden.client.retries",
+		metric.WithDescription("Number of retried attempts."),
+		metric.WithUnit("{retry}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	failovers, err := meter.Int64Counter("relaywarden.client.failovers",
+		metric.WithDescription("Number of attempts failed over to another region."),
+		metric.WithUnit("{failover}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rateLimited, err := meter.Int64Counter("relaywarden.client.rate_limited",
+		metric.WithDescription("Number of attempts rejected with 429 Too Many Requests."),
+		metric.WithUnit("{response}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Observer{
+		tracer:      cfg.tracerProvider.Tracer(ScopeName),
+		propagator:  cfg.propagator,
+		duration:    duration,
+		retries:     retries,
+		failovers:   failovers,
+		rateLimited: rateLimited,
+	}, nil
+}
+
+// StartCall starts a client span for the call.
+func (o *Observer) StartCall(ctx context.Context, call *relaywarden.CallInfo) context.Context {
+	attrs := append(callAttributes(call), PathKey.String(call.Path))
+	if call.ProjectID != "" {
+		attrs = append(attrs, ProjectIDKey.String(call.ProjectID))
+	}
+	if call.TeamID != "" {
+		attrs = append(attrs, TeamIDKey.String(call.TeamID))
+	}
+
+	ctx, _ = o.tracer.Start(ctx, spanName(call),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+// StartAttempt injects the trace context into the request headers.
+func (o *Observer) StartAttempt(req *http.Request, attempt int) {
+	o.propagator.Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// EndAttempt counts attempts rejected with 429 Too Many Requests.
+func (o *Observer) EndAttempt(req *http.Request, resp *http.Response, err error) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	o.rateLimited.Add(req.Context(), 1, metric.WithAttributes(
+		MethodKey.String(req.Method),
+	))
+	trace.SpanFromContext(req.Context()).AddEvent("rate_limited")
+}
+
+// EndCall ends the call span and records the call metrics.
+func (o *Observer) EndCall(ctx context.Context, call *relaywarden.CallInfo, info *option.ResponseInfo, err error) {
+	span := trace.SpanFromContext(ctx)
+	attrs := callAttributes(call)
+	if info.StatusCode != 0 {
+		attrs = append(attrs, StatusCodeKey.Int(info.StatusCode))
+	}
+
+	if info.Retries > 0 {
+		o.retries.Add(ctx, int64(info.Retries), metric.WithAttributes(attrs...))
+	}
+	if info.Failovers > 0 {
+		o.failovers.Add(ctx, int64(info.Failovers), metric.WithAttributes(attrs...))
+	}
+	o.duration.Record(ctx, info.Elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+	span.SetAttributes(RetryCountKey.Int(info.Retries))
+	if info.Failovers > 0 {
+		span.SetAttributes(FailoverCountKey.Int(info.Failovers))
+	}
+	if info.StatusCode != 0 {
+		span.SetAttributes(StatusCodeKey.Int(info.StatusCode))
+	}
+	if info.RequestID != "" {
+		span.SetAttributes(RequestIDKey.String(info.RequestID))
+	}
+	if info.Region != "" {
+		span.SetAttributes(RegionKey.String(info.Region))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// callAttributes returns the low-cardinality attributes identifying a call.
+func callAttributes(call *relaywarden.CallInfo) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{MethodKey.String(call.Method)}
+	if call.Resource != "" {
+		attrs = append(attrs, ResourceKey.String(call.Resource))
+	}
+	if call.Operation != "" {
+		attrs = append(attrs, OperationKey.String(call.Operation))
+	}
+	return attrs
+}
+
+// spanName returns "Resource.Operation" when known, falling back to the
+// operation or the HTTP method.
+func spanName(call *relaywarden.CallInfo) string {
+	switch {
+	case call.Resource != "" && call.Operation != "":
+		return call.Resource + "." + call.Operation
+	case call.Operation != "":
+		return call.Operation
+	default:
+		return call.Method
+	}
+}
@@ -0,0 +1,216 @@
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	relaywarden "github.com/relaywarden/go-sdk"
+	"github.com/relaywarden/go-sdk/models"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*relaywarden.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	observer, err := NewObserver(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	client := relaywarden.NewClient(server.URL, "test-token", relaywarden.ClientOptions{
+		RetryPolicy: &relaywarden.RetryPolicy{MaxAttempts: 3, DisableJitter: true},
+		Observer:    observer,
+	})
+	return client, spans, reader
+}
+
+func attr(attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestObserverSpan(t *testing.T) {
+	var attempts atomic.Int32
+	var traceparent string
+	client, spans, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Write([]byte(`{"data":{"id":"msg_1"}}`))
+	})
+	client.SetProjectID("project-1")
+
+	_, err := client.Messages.SendMessage(context.Background(), &models.SendMessageRequest{
+		From: models.Address{Email: "sender@example.com"}, Subject: "Hi",
+	}, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(ended))
+	}
+	span := ended[0]
+	if span.Name() != "Messages.SendMessage" || span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("Expected client span Messages.SendMessage, got %s %v", span.Name(), span.SpanKind())
+	}
+
+	want := map[attribute.Key]attribute.Value{
+		ResourceKey:   attribute.StringValue("Messages"),
+		OperationKey:  attribute.StringValue("SendMessage"),
+		ProjectIDKey:  attribute.StringValue("project-1"),
+		StatusCodeKey: attribute.IntValue(200),
+		RequestIDKey:  attribute.StringValue("req_123"),
+		RetryCountKey: attribute.IntValue(1),
+	}
+	for key, value := range want {
+		if got, ok := attr(span.Attributes(), key); !ok || got != value {
+			t.Errorf("Expected %s=%v, got %v", key, value.Emit(), got.Emit())
+		}
+	}
+
+	wantParent := "00-" + span.SpanContext().TraceID().String() + "-" + span.SpanContext().SpanID().String() + "-01"
+	if traceparent != wantParent {
+		t.Errorf("Expected traceparent %q, got %q", wantParent, traceparent)
+	}
+}
+
+func TestObserverSpanError(t *testing.T) {
+	client, spans, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Domain not found"}`))
+	})
+
+	if _, err := client.Domains.GetDomain(context.Background(), "dom_1"); err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	span := spans.Ended()[0]
+	if span.Status().Code != codes.Error {
+		t.Errorf("Expected error status, got %v", span.Status())
+	}
+	if got, _ := attr(span.Attributes(), StatusCodeKey); got.AsInt64() != 404 {
+		t.Errorf("Expected status code 404, got %v", got.Emit())
+	}
+}
+
+func TestObserverMetrics(t *testing.T) {
+	var attempts atomic.Int32
+	client, _, reader := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	})
+
+	if _, err := client.Events.ListEvents(context.Background(), nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	got := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			got[m.Name] = m.Data
+		}
+	}
+
+	if sum, ok := got["relaywarden.client.retries"].(metricdata.Sum[int64]); !ok || sum.DataPoints[0].Value != 2 {
+		t.Errorf("Expected 2 retries, got %+v", got["relaywarden.client.retries"])
+	}
+	if sum, ok := got["relaywarden.client.rate_limited"].(metricdata.Sum[int64]); !ok || sum.DataPoints[0].Value != 2 {
+		t.Errorf("Expected 2 rate-limited responses, got %+v", got["relaywarden.client.rate_limited"])
+	}
+	hist, ok := got["relaywarden.client.call.duration"].(metricdata.Histogram[float64])
+	if !ok || hist.DataPoints[0].Count != 1 {
+		t.Fatalf("Expected 1 call duration sample, got %+v", got["relaywarden.client.call.duration"])
+	}
+	if op, _ := hist.DataPoints[0].Attributes.Value(OperationKey); op.AsString() != "ListEvents" {
+		t.Errorf("Expected operation attribute ListEvents, got %v", op.Emit())
+	}
+}
+
+func TestObserverFailover(t *testing.T) {
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(unavailable.Close)
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(healthy.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	observer, err := NewObserver(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client := relaywarden.NewClient("", "test-token", relaywarden.ClientOptions{
+		Observer: observer,
+		Regions: []relaywarden.Region{
+			{Name: "eu-west", BaseURL: unavailable.URL},
+			{Name: "eu-central", BaseURL: healthy.URL},
+		},
+	})
+
+	if _, err := client.Domains.GetDomain(context.Background(), "dom_1"); err != nil {
+		t.Fatalf("Expected the call to fail over, got %v", err)
+	}
+
+	span := spans.Ended()[0]
+	if got, _ := attr(span.Attributes(), RetryCountKey); got != attribute.IntValue(0) {
+		t.Errorf("Expected a failover not to count as a retry, got %v", got.Emit())
+	}
+	if got, _ := attr(span.Attributes(), FailoverCountKey); got != attribute.IntValue(1) {
+		t.Errorf("Expected 1 failover, got %v", got.Emit())
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == "relaywarden.client.retries" {
+				t.Errorf("Expected no retries to be recorded, got %+v", m.Data)
+			}
+		}
+	}
+}
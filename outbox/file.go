@@ -0,0 +1,345 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// walName is the name of the write-ahead log in a FileStore directory.
+const walName = "outbox.wal"
+
+// walRecord is a line of the write-ahead log. A "put" record stores the full
+// state of an entry; a "delete" record removes it.
+type walRecord struct {
+	Op    string `json:"op"`
+	ID    string `json:"id,omitempty"`
+	Dead  bool   `json:"dead,omitempty"`
+	Entry *Entry `json:"entry,omitempty"`
+}
+
+// FileStore is a Store backed by an append-only write-ahead log in a
+// directory. Every change is synced to disk before it returns, and the log is
+// replayed when the store is opened, so entries survive crashes. The log is
+// compacted when opened and as it grows.
+//
+// A FileStore must not be opened by more than one process at a time.
+type FileStore struct {
+	dir string
+
+	mu      sync.Mutex
+	f       *os.File
+	pending map[string]*Entry
+	dead    map[string]*Entry
+	records int
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore opens the store in dir, creating the directory if needed, and
+// recovers the entries from its log. A record left incomplete by a crash
+// while it was being written is discarded.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	s := &FileStore{
+		dir:     dir,
+		pending: make(map[string]*Entry),
+		dead:    make(map[string]*Entry),
+	}
+	if err := s.replay(); err != nil {
+		return nil, err
+	}
+	// Remove logs left behind by a compaction interrupted by a crash.
+	leftovers, _ := filepath.Glob(filepath.Join(dir, walName+".*.tmp"))
+	for _, name := range leftovers {
+		os.Remove(name)
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// replay rebuilds the entries from the log.
+func (s *FileStore) replay() error {
+	data, err := os.ReadFile(filepath.Join(s.dir, walName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for len(data) > 0 {
+		line, rest, complete := bytes.Cut(data, []byte("\n"))
+		var rec walRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			if !complete {
+				// The last record was cut short by a crash; it was never
+				// acknowledged, so it is dropped when the log is compacted.
+				return nil
+			}
+			return fmt.Errorf("outbox: corrupt log %s: %w", filepath.Join(s.dir, walName), err)
+		}
+		s.apply(rec)
+		data = rest
+	}
+	return nil
+}
+
+// apply updates the in-memory entries with a log record.
+func (s *FileStore) apply(rec walRecord) {
+	switch rec.Op {
+	case "put":
+		delete(s.pending, rec.Entry.ID)
+		delete(s.dead, rec.Entry.ID)
+		if rec.Dead {
+			s.dead[rec.Entry.ID] = rec.Entry
+		} else {
+			s.pending[rec.Entry.ID] = rec.Entry
+		}
+	case "delete":
+		delete(s.pending, rec.ID)
+		delete(s.dead, rec.ID)
+	}
+}
+
+// append writes a record to the log and syncs it, then applies it.
+func (s *FileStore) append(rec walRecord) error {
+	if s.f == nil {
+		return os.ErrClosed
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = s.f.Write(append(line, '\n'))
+	if err == nil {
+		err = s.f.Sync()
+	}
+	if err != nil {
+		// A partly written record must remain the last one in the log, where
+		// it is discarded when the store is reopened.
+		s.f.Close()
+		s.f = nil
+		return err
+	}
+	// Apply the record as logged, so stored entries share no memory with the
+	// caller's, including their requests.
+	var logged walRecord
+	if err := json.Unmarshal(line, &logged); err != nil {
+		return err
+	}
+	s.apply(logged)
+	s.records++
+	if s.records > 2*(len(s.pending)+len(s.dead))+1024 {
+		return s.compact()
+	}
+	return nil
+}
+
+// compact rewrites the log with a single record per entry. The new log is
+// written to a temporary file and renamed over the old one, so a crash
+// during compaction leaves either log intact.
+func (s *FileStore) compact() error {
+	path := filepath.Join(s.dir, walName)
+	tmp, err := os.CreateTemp(s.dir, walName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	records := 0
+	for _, set := range []struct {
+		entries map[string]*Entry
+		dead    bool
+	}{{s.pending, false}, {s.dead, true}} {
+		for _, e := range sortEntries(set.entries) {
+			if err := enc.Encode(walRecord{Op: "put", Dead: set.dead, Entry: e}); err != nil {
+				tmp.Close()
+				return err
+			}
+			records++
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if s.f != nil {
+		s.f.Close()
+		s.f = nil
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	if err := syncDir(s.dir); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	s.f = f
+	s.records = records
+	return nil
+}
+
+// syncDir syncs a directory so a rename within it is durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil && !os.IsPermission(err) {
+		return err
+	}
+	return nil
+}
+
+// sortEntries returns the entries oldest first.
+func sortEntries(entries map[string]*Entry) []*Entry {
+	list := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, e)
+	}
+	slices.SortFunc(list, func(a, b *Entry) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+	return list
+}
+
+// cloneEntry returns a deep copy of e, so callers can't modify stored entries
+// or their requests.
+func cloneEntry(e *Entry) (*Entry, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var c Entry
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Add implements Store.
+func (s *FileStore) Add(ctx context.Context, e *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending[e.ID] != nil || s.dead[e.ID] != nil {
+		return ErrDuplicate
+	}
+	return s.append(walRecord{Op: "put", Entry: e})
+}
+
+// Due implements Store.
+func (s *FileStore) Due(ctx context.Context, now time.Time, limit int) ([]*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*Entry
+	for _, e := range sortEntries(s.pending) {
+		if len(due) == limit {
+			break
+		}
+		if !e.NextAttempt.After(now) {
+			c, err := cloneEntry(e)
+			if err != nil {
+				return nil, err
+			}
+			due = append(due, c)
+		}
+	}
+	return due, nil
+}
+
+// Retry implements Store.
+func (s *FileStore) Retry(ctx context.Context, e *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending[e.ID] == nil {
+		return ErrNotFound
+	}
+	return s.append(walRecord{Op: "put", Entry: e})
+}
+
+// Complete implements Store.
+func (s *FileStore) Complete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending[id] == nil {
+		return ErrNotFound
+	}
+	return s.append(walRecord{Op: "delete", ID: id})
+}
+
+// DeadLetter implements Store.
+func (s *FileStore) DeadLetter(ctx context.Context, e *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending[e.ID] == nil {
+		return ErrNotFound
+	}
+	return s.append(walRecord{Op: "put", Dead: true, Entry: e})
+}
+
+// DeadLetters implements Store.
+func (s *FileStore) DeadLetters(ctx context.Context) ([]*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := sortEntries(s.dead)
+	for i, e := range list {
+		c, err := cloneEntry(e)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = c
+	}
+	return list, nil
+}
+
+// Requeue implements Store.
+func (s *FileStore) Requeue(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.dead[id]
+	if e == nil {
+		return ErrNotFound
+	}
+	requeued := *e
+	requeued.Attempts, requeued.NextAttempt, requeued.LastError = 0, at, ""
+	return s.append(walRecord{Op: "put", Entry: &requeued})
+}
+
+// Close closes the log.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
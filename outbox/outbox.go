@@ -0,0 +1,284 @@
+// Package outbox provides a durable local outbox for at-least-once message
+// delivery.
+//
+// Messages are persisted to a Store before they are sent, so a message
+// accepted by Enqueue survives a crash or restart of the process. A
+// dispatcher sends pending messages through the client, retrying failures
+// with exponential backoff. Every attempt for a message carries the same
+// idempotency key, so a message re-sent after a crash is not delivered twice.
+// Messages the API rejects as invalid, or that fail too many times, are moved
+// to a dead-letter store:
+//
+//	store, err := outbox.NewFileStore("/var/lib/myapp/outbox")
+//	if err != nil {
+//		return err
+//	}
+//	defer store.Close()
+//
+//	box := outbox.New(store, client.Messages)
+//	go box.Run(ctx)
+//
+//	if _, err := box.Enqueue(ctx, "welcome-"+userID, req); err != nil {
+//		return err
+//	}
+//
+// A store must be used by a single Outbox at a time.
+package outbox
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	stderrors "errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// Errors returned by stores.
+var (
+	ErrDuplicate = stderrors.New("outbox: duplicate entry ID")
+	ErrNotFound  = stderrors.New("outbox: entry not found")
+)
+
+// Entry is a message in the outbox.
+type Entry struct {
+	// ID identifies the entry and derives its idempotency key.
+	ID      string                     `json:"id"`
+	Request *models.SendMessageRequest `json:"request"`
+	// Attempts is the number of failed attempts to send the message.
+	Attempts int `json:"attempts"`
+	// NextAttempt is when the message is next due to be sent.
+	NextAttempt time.Time `json:"next_attempt"`
+	// LastError describes the last failed attempt.
+	LastError string    `json:"last_error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IdempotencyKey returns the idempotency key sent with every attempt.
+func (e *Entry) IdempotencyKey() string {
+	return "outbox-" + e.ID
+}
+
+// Store persists outbox entries. Pending entries are those added and not yet
+// completed or dead-lettered.
+type Store interface {
+	// Add persists a new pending entry. It returns ErrDuplicate if an entry
+	// with the same ID exists.
+	Add(ctx context.Context, e *Entry) error
+	// Due returns up to limit pending entries due at now, oldest first.
+	Due(ctx context.Context, now time.Time, limit int) ([]*Entry, error)
+	// Retry records a failed attempt of a pending entry: its Attempts,
+	// NextAttempt and LastError.
+	Retry(ctx context.Context, e *Entry) error
+	// Complete removes a pending entry after its message was sent.
+	Complete(ctx context.Context, id string) error
+	// DeadLetter moves a pending entry to the dead-letter store, recording
+	// its Attempts and LastError.
+	DeadLetter(ctx context.Context, e *Entry) error
+	// DeadLetters returns the dead-lettered entries, oldest first.
+	DeadLetters(ctx context.Context) ([]*Entry, error)
+	// Requeue moves a dead-lettered entry back to pending, due at at, with
+	// its attempts reset. It returns ErrNotFound if there is no such entry.
+	Requeue(ctx context.Context, id string, at time.Time) error
+}
+
+// Sender sends messages. *resources.Messages implements it.
+type Sender interface {
+	SendMessage(ctx context.Context, req *models.SendMessageRequest, idempotencyKey string, opts ...option.RequestOption) (*models.Message, error)
+}
+
+// Options configures an Outbox.
+type Options struct {
+	// MaxAttempts is the number of failed attempts after which a message is
+	// dead-lettered. Defaults to 10.
+	MaxAttempts int
+	// Backoff returns the delay before the next attempt after the given
+	// number of failed attempts. Defaults to exponential backoff from one
+	// second up to one hour, with jitter.
+	Backoff func(attempts int) time.Duration
+	// PollInterval is how often Run checks for due messages when the outbox
+	// is idle. Defaults to one second.
+	PollInterval time.Duration
+	// BatchSize is the number of due messages loaded at once. Defaults to 100.
+	BatchSize int
+	// OnSent, if set, is called after a message is sent.
+	OnSent func(e *Entry, message *models.Message)
+	// OnDeadLetter, if set, is called after a message is dead-lettered.
+	OnDeadLetter func(e *Entry, err error)
+	// RequestOptions are applied to every send.
+	RequestOptions []option.RequestOption
+}
+
+// Outbox persists messages and dispatches them through a Sender.
+type Outbox struct {
+	store  Store
+	sender Sender
+	opts   Options
+	now    func() time.Time
+}
+
+// New creates an Outbox that stores messages in store and sends them with sender.
+func New(store Store, sender Sender, opts ...Options) *Outbox {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 10
+	}
+	if o.Backoff == nil {
+		o.Backoff = DefaultBackoff
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = time.Second
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 100
+	}
+	return &Outbox{store: store, sender: sender, opts: o, now: time.Now}
+}
+
+// DefaultBackoff doubles the delay after each failed attempt, from one second
+// up to one hour, and randomizes it by up to half to spread retries out.
+func DefaultBackoff(attempts int) time.Duration {
+	d := time.Hour
+	if attempts < 13 {
+		d = min(time.Second<<max(attempts-1, 0), time.Hour)
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// Enqueue persists a message for delivery and returns its entry. The message
+// is durable once Enqueue returns. id identifies the message, such as a
+// notification ID, so that enqueuing the same message twice fails with
+// ErrDuplicate; if empty, a random ID is generated.
+func (o *Outbox) Enqueue(ctx context.Context, id string, req *models.SendMessageRequest) (*Entry, error) {
+	if req == nil {
+		return nil, &errors.InvalidArgumentError{Name: "req", Reason: "must not be nil"}
+	}
+	if id == "" {
+		var b [16]byte
+		if _, err := crand.Read(b[:]); err != nil {
+			return nil, err
+		}
+		id = hex.EncodeToString(b[:])
+	}
+	now := o.now().UTC()
+	e := &Entry{ID: id, Request: req, NextAttempt: now, CreatedAt: now}
+	if err := o.store.Add(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Flush sends the messages that are due, up to BatchSize, and returns the
+// number sent. Failed messages are scheduled for a retry or dead-lettered;
+// an error is returned only if the store fails or ctx is canceled.
+func (o *Outbox) Flush(ctx context.Context) (int, error) {
+	n, _, err := o.flush(ctx)
+	return n, err
+}
+
+func (o *Outbox) flush(ctx context.Context) (sent, due int, err error) {
+	entries, err := o.store.Due(ctx, o.now().UTC(), o.opts.BatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, e := range entries {
+		ok, err := o.dispatch(ctx, e)
+		if err != nil {
+			return sent, len(entries), err
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, len(entries), nil
+}
+
+// Run dispatches due messages until ctx is canceled, polling every
+// PollInterval while the outbox is idle. Messages left pending by a previous
+// run, including any being sent when the process stopped, are sent again.
+// Run returns ctx.Err() when ctx is canceled, or the first store error.
+func (o *Outbox) Run(ctx context.Context) error {
+	for {
+		_, due, err := o.flush(ctx)
+		if err != nil {
+			return err
+		}
+		if due == o.opts.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.opts.PollInterval):
+		}
+	}
+}
+
+// DeadLetters returns the dead-lettered entries.
+func (o *Outbox) DeadLetters(ctx context.Context) ([]*Entry, error) {
+	return o.store.DeadLetters(ctx)
+}
+
+// Requeue moves a dead-lettered entry back to pending, to be sent again with
+// the same idempotency key.
+func (o *Outbox) Requeue(ctx context.Context, id string) error {
+	return o.store.Requeue(ctx, id, o.now().UTC())
+}
+
+// dispatch sends one entry and records the outcome. It reports whether the
+// message was sent, and returns an error only if the store fails or ctx is
+// canceled, in which case the entry stays pending as it was.
+func (o *Outbox) dispatch(ctx context.Context, e *Entry) (bool, error) {
+	message, err := o.sender.SendMessage(ctx, e.Request, e.IdempotencyKey(), o.opts.RequestOptions...)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if err == nil {
+		if err := o.store.Complete(ctx, e.ID); err != nil {
+			return false, err
+		}
+		if o.opts.OnSent != nil {
+			o.opts.OnSent(e, message)
+		}
+		return true, nil
+	}
+
+	e.Attempts++
+	e.LastError = err.Error()
+	if poison(err) || e.Attempts >= o.opts.MaxAttempts {
+		if err := o.store.DeadLetter(ctx, e); err != nil {
+			return false, err
+		}
+		if o.opts.OnDeadLetter != nil {
+			o.opts.OnDeadLetter(e, err)
+		}
+		return false, nil
+	}
+	e.NextAttempt = o.now().UTC().Add(o.opts.Backoff(e.Attempts))
+	return false, o.store.Retry(ctx, e)
+}
+
+// poison reports whether err means the message itself is rejected, so
+// sending it again cannot succeed. Failures such as outages, rate limiting or
+// an expired token are retried instead.
+func poison(err error) bool {
+	if stderrors.Is(err, errors.ErrValidation) || stderrors.Is(err, errors.ErrInvalidArgument) {
+		return true
+	}
+	var apiErr *errors.APIError
+	if stderrors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case http.StatusBadRequest, http.StatusRequestEntityTooLarge:
+			return true
+		}
+	}
+	return false
+}
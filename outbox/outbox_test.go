@@ -0,0 +1,194 @@
+package outbox
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// fakeSender records the idempotency keys it is called with and returns the
+// errors queued for each message subject.
+type fakeSender struct {
+	mu     sync.Mutex
+	keys   []string
+	errors map[string][]error
+}
+
+func (s *fakeSender) SendMessage(ctx context.Context, req *models.SendMessageRequest, key string, opts ...option.RequestOption) (*models.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, key)
+	if errs := s.errors[req.Subject]; len(errs) > 0 {
+		s.errors[req.Subject] = errs[1:]
+		if errs[0] != nil {
+			return nil, errs[0]
+		}
+	}
+	return &models.Message{ID: "msg-" + req.Subject, Status: "accepted"}, nil
+}
+
+func newTestOutbox(t *testing.T, sender Sender, opts Options) (*Outbox, *time.Time) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	box := New(store, sender, opts)
+	box.now = func() time.Time { return now }
+	return box, &now
+}
+
+func serverError() error {
+	return &errors.ServerError{APIError: &errors.APIError{Code: 503, Message: "unavailable"}}
+}
+
+func TestOutboxRetriesWithStableKey(t *testing.T) {
+	ctx := context.Background()
+	sender := &fakeSender{errors: map[string][]error{"Hi": {serverError(), serverError()}}}
+	var sent []string
+	box, now := newTestOutbox(t, sender, Options{
+		Backoff: func(attempts int) time.Duration { return time.Duration(attempts) * time.Minute },
+		OnSent:  func(e *Entry, m *models.Message) { sent = append(sent, m.ID) },
+	})
+
+	if _, err := box.Enqueue(ctx, "welcome-42", &models.SendMessageRequest{Subject: "Hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := box.Enqueue(ctx, "welcome-42", &models.SendMessageRequest{Subject: "Hi"}); !stderrors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate enqueuing the same ID, got %v", err)
+	}
+
+	for _, step := range []struct {
+		advance time.Duration
+		want    int
+	}{
+		{0, 0},                // first attempt fails, retry in 1m
+		{30 * time.Second, 0}, // not due yet
+		{30 * time.Second, 0}, // second attempt fails, retry in 2m
+		{2 * time.Minute, 1},  // third attempt succeeds
+	} {
+		*now = now.Add(step.advance)
+		n, err := box.Flush(ctx)
+		if err != nil || n != step.want {
+			t.Fatalf("Expected %d sent after %s, got %d, %v", step.want, step.advance, n, err)
+		}
+	}
+
+	if len(sender.keys) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", len(sender.keys))
+	}
+	for _, key := range sender.keys {
+		if key != "outbox-welcome-42" {
+			t.Errorf("Expected every attempt to use the same idempotency key, got %q", key)
+		}
+	}
+	if len(sent) != 1 || sent[0] != "msg-Hi" {
+		t.Errorf("Expected OnSent once, got %v", sent)
+	}
+	if n, _ := box.Flush(ctx); n != 0 {
+		t.Errorf("Expected the outbox to be empty, sent %d", n)
+	}
+}
+
+func TestOutboxDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	invalid := &errors.ValidationErrorResponse{APIError: &errors.APIError{Code: 422, Message: "invalid recipient"}}
+	sender := &fakeSender{errors: map[string][]error{
+		"poison": {invalid},
+		"flaky":  {serverError(), serverError(), serverError()},
+	}}
+	var deadLettered []string
+	box, now := newTestOutbox(t, sender, Options{
+		MaxAttempts:  3,
+		Backoff:      func(int) time.Duration { return time.Second },
+		OnDeadLetter: func(e *Entry, err error) { deadLettered = append(deadLettered, e.ID) },
+	})
+
+	box.Enqueue(ctx, "poison", &models.SendMessageRequest{Subject: "poison"})
+	box.Enqueue(ctx, "flaky", &models.SendMessageRequest{Subject: "flaky"})
+	for range 3 {
+		if _, err := box.Flush(ctx); err != nil {
+			t.Fatal(err)
+		}
+		*now = now.Add(time.Second)
+	}
+
+	letters, err := box.DeadLetters(ctx)
+	if err != nil || len(letters) != 2 {
+		t.Fatalf("Expected 2 dead letters, got %v, %v", letters, err)
+	}
+	if len(sender.keys) != 4 {
+		t.Errorf("Expected the poison message to be tried once and the flaky one 3 times, got %d attempts", len(sender.keys))
+	}
+	if len(deadLettered) != 2 || deadLettered[0] != "poison" || deadLettered[1] != "flaky" {
+		t.Errorf("Expected OnDeadLetter for poison then flaky, got %v", deadLettered)
+	}
+
+	if err := box.Requeue(ctx, "poison"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := box.Flush(ctx); err != nil || n != 1 {
+		t.Errorf("Expected the requeued message to be sent, got %d, %v", n, err)
+	}
+}
+
+func TestOutboxRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	box := New(store, &fakeSender{})
+	if _, err := box.Enqueue(ctx, "order-1", &models.SendMessageRequest{Subject: "Receipt"}); err != nil {
+		t.Fatal(err)
+	}
+	// The process stops before the message is sent.
+	store.Close()
+
+	store, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	sender := &fakeSender{}
+	box = New(store, sender, Options{PollInterval: time.Millisecond})
+
+	runCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- box.Run(runCtx) }()
+	for {
+		sender.mu.Lock()
+		n := len(sender.keys)
+		sender.mu.Unlock()
+		if n > 0 || runCtx.Err() != nil {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !stderrors.Is(err, context.Canceled) {
+		t.Errorf("Expected Run to stop with context.Canceled, got %v", err)
+	}
+	if len(sender.keys) != 1 || sender.keys[0] != "outbox-order-1" {
+		t.Errorf("Expected the pending message to be sent after the restart, got %v", sender.keys)
+	}
+}
+
+func TestDefaultBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{1: time.Second, 4: 8 * time.Second, 20: time.Hour} {
+		if got := DefaultBackoff(attempts); got < want/2 || got > want {
+			t.Errorf("DefaultBackoff(%d) = %s, expected between %s and %s", attempts, got, want/2, want)
+		}
+	}
+}
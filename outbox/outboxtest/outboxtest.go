@@ -0,0 +1,131 @@
+// Package outboxtest implements support for testing outbox stores.
+package outboxtest
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/outbox"
+)
+
+func newEntry(id string, created time.Time) *outbox.Entry {
+	return &outbox.Entry{
+		ID:          id,
+		Request:     &models.SendMessageRequest{Subject: "Message " + id, To: []models.Address{{Email: "user@example.com"}}},
+		NextAttempt: created,
+		CreatedAt:   created,
+	}
+}
+
+// TestStore checks the behavior every outbox.Store implementation must have.
+// s must be empty, and is left with pending entries.
+func TestStore(t *testing.T, s outbox.Store) {
+	t.Helper()
+	ctx := context.Background()
+	t0 := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for i, id := range []string{"b", "a", "c"} {
+		e := newEntry(id, t0.Add(time.Duration(i)*time.Second))
+		if err := s.Add(ctx, e); err != nil {
+			t.Fatalf("Add(%s): %v", id, err)
+		}
+		// Changes to the request after Add must not reach the store.
+		e.Request.Subject = "Changed"
+		e.Request.To[0].Email = "changed@example.com"
+	}
+	if err := s.Add(ctx, newEntry("a", t0)); !stderrors.Is(err, outbox.ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate, got %v", err)
+	}
+
+	due, err := s.Due(ctx, t0.Add(time.Second), 10)
+	if err != nil || len(due) != 2 || due[0].ID != "b" || due[1].ID != "a" {
+		t.Fatalf("Expected b and a to be due, got %v, %v", due, err)
+	}
+	if due[0].Request.Subject != "Message b" || !due[0].CreatedAt.Equal(t0) {
+		t.Errorf("Expected the entry to round-trip, got %+v", due[0])
+	}
+	due[1].Request.To[0].Email = "changed@example.com"
+	if again, _ := s.Due(ctx, t0.Add(time.Second), 10); again[1].Request.To[0].Email != "user@example.com" {
+		t.Errorf("Expected the stored request to be unaffected, got %+v", again[1].Request.To)
+	}
+	if due, _ := s.Due(ctx, t0.Add(time.Hour), 1); len(due) != 1 || due[0].ID != "b" {
+		t.Errorf("Expected the limit to apply, got %v", due)
+	}
+
+	retry := due[0]
+	retry.Attempts, retry.NextAttempt, retry.LastError = 1, t0.Add(time.Minute), "server error"
+	if err := s.Retry(ctx, retry); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if due, _ := s.Due(ctx, t0.Add(30*time.Second), 10); len(due) != 2 || due[0].ID != "a" {
+		t.Errorf("Expected b to be scheduled later, got %v", due)
+	}
+	due, _ = s.Due(ctx, t0.Add(time.Minute), 10)
+	if len(due) != 3 || due[0].Attempts != 1 || due[0].LastError != "server error" {
+		t.Errorf("Expected the retry to be recorded, got %+v", due[0])
+	}
+
+	if err := s.Complete(ctx, "a"); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if err := s.Complete(ctx, "a"); !stderrors.Is(err, outbox.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound completing twice, got %v", err)
+	}
+
+	dead := newEntry("c", t0.Add(2*time.Second))
+	dead.Attempts, dead.LastError = 3, "validation failed"
+	if err := s.DeadLetter(ctx, dead); err != nil {
+		t.Fatalf("DeadLetter: %v", err)
+	}
+	letters, err := s.DeadLetters(ctx)
+	if err != nil || len(letters) != 1 || letters[0].ID != "c" || letters[0].LastError != "validation failed" {
+		t.Fatalf("Expected c to be dead-lettered, got %v, %v", letters, err)
+	}
+	if err := s.Add(ctx, newEntry("c", t0)); !stderrors.Is(err, outbox.ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate adding a dead-lettered ID, got %v", err)
+	}
+	if due, _ := s.Due(ctx, t0.Add(time.Hour), 10); len(due) != 1 || due[0].ID != "b" {
+		t.Errorf("Expected only b to be pending, got %v", due)
+	}
+
+	if err := s.Requeue(ctx, "c", t0.Add(time.Hour)); err != nil {
+		t.Fatalf("Requeue: %v", err)
+	}
+	if err := s.Requeue(ctx, "c", t0); !stderrors.Is(err, outbox.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound requeuing a pending entry, got %v", err)
+	}
+	due, _ = s.Due(ctx, t0.Add(time.Hour), 10)
+	if len(due) != 2 || due[1].ID != "c" || due[1].Attempts != 0 || due[1].LastError != "" {
+		t.Errorf("Expected c to be pending again with its attempts reset, got %v", due)
+	}
+
+	// Exactly one of several concurrent adds of the same ID succeeds.
+	const adders = 8
+	errs := make(chan error, adders)
+	var wg sync.WaitGroup
+	for range adders {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- s.Add(ctx, newEntry("d", t0))
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	added := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			added++
+		case !stderrors.Is(err, outbox.ErrDuplicate):
+			t.Errorf("Expected ErrDuplicate from a concurrent add, got %v", err)
+		}
+	}
+	if added != 1 {
+		t.Errorf("Expected 1 concurrent add to succeed, got %d", added)
+	}
+}
@@ -0,0 +1,60 @@
+package outbox_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/outbox"
+	"github.com/relaywarden/go-sdk/outbox/outboxtest"
+)
+
+func TestFileStore(t *testing.T) {
+	s, err := outbox.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	outboxtest.TestStore(t, s)
+}
+
+func TestSQLStore(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		opts []outbox.SQLOption
+	}{
+		{"question marks", []outbox.SQLOption{outbox.WithTable("outbox_messages")}},
+		{"dollar placeholders", []outbox.SQLOption{outbox.WithDollarPlaceholders()}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// outboxfake is registered by the in-package tests. Each name
+			// is a separate database, which must be empty.
+			db, err := sql.Open("outboxfake", fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			s, err := outbox.NewSQLStore(db, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := s.CreateTable(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			outboxtest.TestStore(t, s)
+		})
+	}
+
+	db, err := sql.Open("outboxfake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := outbox.NewSQLStore(db, outbox.WithTable("outbox; DROP TABLE users")); err == nil {
+		t.Error("Expected an error for an invalid table name")
+	}
+}
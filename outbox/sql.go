@@ -0,0 +1,200 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/relaywarden/go-sdk/models"
+)
+
+// DefaultTable is the table used by SQLStore by default.
+const DefaultTable = "relaywarden_outbox"
+
+var tableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// SQLStore is a Store backed by a database/sql table, for applications that
+// already keep their state in a database or run on hosts without durable
+// local disks.
+//
+// Times are stored as Unix nanoseconds and requests as JSON, so the table
+// works with any SQL database. Call CreateTable to create it, or create it
+// with the columns listed there as part of your migrations. An index on
+// (dead, next_attempt) is recommended for large outboxes.
+type SQLStore struct {
+	db     *sql.DB
+	table  string
+	dollar bool
+}
+
+var _ Store = (*SQLStore)(nil)
+
+// SQLOption configures an SQLStore.
+type SQLOption func(*SQLStore)
+
+// WithTable sets the table name. Defaults to DefaultTable.
+func WithTable(name string) SQLOption {
+	return func(s *SQLStore) {
+		s.table = name
+	}
+}
+
+// WithDollarPlaceholders uses $1, $2, ... query placeholders, as required by
+// PostgreSQL drivers, instead of ?.
+func WithDollarPlaceholders() SQLOption {
+	return func(s *SQLStore) {
+		s.dollar = true
+	}
+}
+
+// NewSQLStore creates a Store backed by a table in db.
+func NewSQLStore(db *sql.DB, opts ...SQLOption) (*SQLStore, error) {
+	s := &SQLStore{db: db, table: DefaultTable}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if !tableName.MatchString(s.table) {
+		return nil, fmt.Errorf("outbox: invalid table name %q", s.table)
+	}
+	return s, nil
+}
+
+// CreateTable creates the outbox table if it does not exist.
+func (s *SQLStore) CreateTable(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+s.table+` (
+	id VARCHAR(255) NOT NULL PRIMARY KEY,
+	request TEXT NOT NULL,
+	attempts INTEGER NOT NULL,
+	next_attempt BIGINT NOT NULL,
+	last_error TEXT NOT NULL,
+	created_at BIGINT NOT NULL,
+	dead INTEGER NOT NULL
+)`)
+	return err
+}
+
+// query replaces the table placeholder {table} and, if configured, the ?
+// placeholders with numbered ones.
+func (s *SQLStore) query(q string) string {
+	q = strings.ReplaceAll(q, "{table}", s.table)
+	if !s.dollar {
+		return q
+	}
+	var b strings.Builder
+	n := 0
+	for _, c := range q {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+const selectEntry = `SELECT id, request, attempts, next_attempt, last_error, created_at FROM {table} `
+
+// Add implements Store.
+func (s *SQLStore) Add(ctx context.Context, e *Entry) error {
+	request, err := json.Marshal(e.Request)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.query(`INSERT INTO {table}
+		(id, request, attempts, next_attempt, last_error, created_at, dead)
+		VALUES (?, ?, ?, ?, ?, ?, 0)`),
+		e.ID, string(request), e.Attempts, e.NextAttempt.UnixNano(), e.LastError, e.CreatedAt.UnixNano())
+	if err == nil {
+		return nil
+	}
+	// Drivers report primary key violations in their own way, so look for
+	// the entry that caused the insert to fail.
+	var n int
+	if qerr := s.db.QueryRowContext(ctx, s.query(`SELECT COUNT(*) FROM {table} WHERE id = ?`), e.ID).Scan(&n); qerr == nil && n > 0 {
+		return ErrDuplicate
+	}
+	return err
+}
+
+// Due implements Store.
+func (s *SQLStore) Due(ctx context.Context, now time.Time, limit int) ([]*Entry, error) {
+	return s.list(ctx, selectEntry+`WHERE dead = 0 AND next_attempt <= ? ORDER BY created_at, id LIMIT ?`, now.UnixNano(), limit)
+}
+
+// DeadLetters implements Store.
+func (s *SQLStore) DeadLetters(ctx context.Context) ([]*Entry, error) {
+	return s.list(ctx, selectEntry+`WHERE dead = 1 ORDER BY created_at, id`)
+}
+
+func (s *SQLStore) list(ctx context.Context, q string, args ...interface{}) ([]*Entry, error) {
+	rows, err := s.db.QueryContext(ctx, s.query(q), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*Entry
+	for rows.Next() {
+		var (
+			e                      Entry
+			request                string
+			nextAttempt, createdAt int64
+		)
+		if err := rows.Scan(&e.ID, &request, &e.Attempts, &nextAttempt, &e.LastError, &createdAt); err != nil {
+			return nil, err
+		}
+		e.Request = new(models.SendMessageRequest)
+		if err := json.Unmarshal([]byte(request), e.Request); err != nil {
+			return nil, fmt.Errorf("outbox: decoding entry %s: %w", e.ID, err)
+		}
+		e.NextAttempt = time.Unix(0, nextAttempt).UTC()
+		e.CreatedAt = time.Unix(0, createdAt).UTC()
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// exec runs a statement that must change exactly one entry.
+func (s *SQLStore) exec(ctx context.Context, q string, args ...interface{}) error {
+	res, err := s.db.ExecContext(ctx, s.query(q), args...)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Retry implements Store.
+func (s *SQLStore) Retry(ctx context.Context, e *Entry) error {
+	return s.exec(ctx, `UPDATE {table} SET attempts = ?, next_attempt = ?, last_error = ? WHERE id = ? AND dead = 0`,
+		e.Attempts, e.NextAttempt.UnixNano(), e.LastError, e.ID)
+}
+
+// Complete implements Store.
+func (s *SQLStore) Complete(ctx context.Context, id string) error {
+	return s.exec(ctx, `DELETE FROM {table} WHERE id = ? AND dead = 0`, id)
+}
+
+// DeadLetter implements Store.
+func (s *SQLStore) DeadLetter(ctx context.Context, e *Entry) error {
+	return s.exec(ctx, `UPDATE {table} SET dead = 1, attempts = ?, last_error = ? WHERE id = ? AND dead = 0`,
+		e.Attempts, e.LastError, e.ID)
+}
+
+// Requeue implements Store.
+func (s *SQLStore) Requeue(ctx context.Context, id string, at time.Time) error {
+	return s.exec(ctx, `UPDATE {table} SET dead = 0, attempts = 0, next_attempt = ?, last_error = '' WHERE id = ? AND dead = 1`,
+		at.UnixNano(), id)
+}
@@ -0,0 +1,225 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// fakeDriver is a database/sql driver that understands the statements issued
+// by SQLStore and keeps the rows of each named database in memory, so the
+// store can be tested without a database dependency.
+type fakeDriver struct {
+	mu  sync.Mutex
+	dbs map[string]*fakeTable
+}
+
+func init() {
+	sql.Register("outboxfake", &fakeDriver{dbs: make(map[string]*fakeTable)})
+}
+
+// fakeTable holds the rows of the outbox table, keyed by ID.
+type fakeTable struct {
+	mu   sync.Mutex
+	rows map[string]*fakeRow
+}
+
+type fakeRow struct {
+	id, request, lastError           string
+	attempts, nextAttempt, createdAt int64
+	dead                             bool
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	t, ok := d.dbs[name]
+	if !ok {
+		t = &fakeTable{rows: make(map[string]*fakeRow)}
+		d.dbs[name] = t
+	}
+	return &fakeConn{table: t}, nil
+}
+
+type fakeConn struct {
+	table *fakeTable
+}
+
+var (
+	_ driver.ExecerContext  = (*fakeConn)(nil)
+	_ driver.QueryerContext = (*fakeConn)(nil)
+)
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("fake driver: Prepare is not supported")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+// Begin fails: SQLStore must not depend on transactions, which the fake
+// driver can't isolate.
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("fake driver: transactions are not supported")
+}
+
+var (
+	placeholder = regexp.MustCompile(`\$\d+`)
+	space       = regexp.MustCompile(`\s+`)
+	// tableWord matches the table name, the word after FROM, INTO or UPDATE.
+	tableWord = regexp.MustCompile(`(?:FROM|INTO|UPDATE) (\S+)`)
+)
+
+// normalize rewrites numbered placeholders to ? and collapses whitespace, so
+// statements can be matched regardless of the placeholder style.
+func normalize(query string) string {
+	query = placeholder.ReplaceAllString(query, "?")
+	return strings.TrimSpace(space.ReplaceAllString(query, " "))
+}
+
+// fakeStatements are the statements SQLStore issues, with the table name
+// replaced by {table}.
+var fakeStatements = map[string]func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error){
+	`SELECT COUNT(*) FROM {table} WHERE id = ?`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		n := int64(0)
+		if t.rows[args[0].Value.(string)] != nil {
+			n = 1
+		}
+		return 0, [][]driver.Value{{n}}, nil
+	},
+	`INSERT INTO {table} (id, request, attempts, next_attempt, last_error, created_at, dead) VALUES (?, ?, ?, ?, ?, ?, 0)`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		r := &fakeRow{
+			id:          args[0].Value.(string),
+			request:     args[1].Value.(string),
+			attempts:    args[2].Value.(int64),
+			nextAttempt: args[3].Value.(int64),
+			lastError:   args[4].Value.(string),
+			createdAt:   args[5].Value.(int64),
+		}
+		if t.rows[r.id] != nil {
+			return 0, nil, fmt.Errorf("fake driver: UNIQUE constraint failed: id")
+		}
+		t.rows[r.id] = r
+		return 1, nil, nil
+	},
+	selectEntry + `WHERE dead = 0 AND next_attempt <= ? ORDER BY created_at, id LIMIT ?`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		rows := t.sorted(func(r *fakeRow) bool { return !r.dead && r.nextAttempt <= args[0].Value.(int64) })
+		return 0, rows[:min(len(rows), int(args[1].Value.(int64)))], nil
+	},
+	selectEntry + `WHERE dead = 1 ORDER BY created_at, id`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		return 0, t.sorted(func(r *fakeRow) bool { return r.dead }), nil
+	},
+	`UPDATE {table} SET attempts = ?, next_attempt = ?, last_error = ? WHERE id = ? AND dead = 0`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		return t.update(args[3].Value.(string), false, func(r *fakeRow) {
+			r.attempts, r.nextAttempt, r.lastError = args[0].Value.(int64), args[1].Value.(int64), args[2].Value.(string)
+		}), nil, nil
+	},
+	`DELETE FROM {table} WHERE id = ? AND dead = 0`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		id := args[0].Value.(string)
+		return t.update(id, false, func(*fakeRow) { delete(t.rows, id) }), nil, nil
+	},
+	`UPDATE {table} SET dead = 1, attempts = ?, last_error = ? WHERE id = ? AND dead = 0`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		return t.update(args[2].Value.(string), false, func(r *fakeRow) {
+			r.dead, r.attempts, r.lastError = true, args[0].Value.(int64), args[1].Value.(string)
+		}), nil, nil
+	},
+	`UPDATE {table} SET dead = 0, attempts = 0, next_attempt = ?, last_error = '' WHERE id = ? AND dead = 1`: func(t *fakeTable, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+		return t.update(args[1].Value.(string), true, func(r *fakeRow) {
+			r.dead, r.attempts, r.nextAttempt, r.lastError = false, 0, args[0].Value.(int64), ""
+		}), nil, nil
+	},
+}
+
+// sorted returns the rows matching keep as selectEntry columns, oldest first.
+func (t *fakeTable) sorted(keep func(*fakeRow) bool) [][]driver.Value {
+	var rows []*fakeRow
+	for _, r := range t.rows {
+		if keep(r) {
+			rows = append(rows, r)
+		}
+	}
+	slices.SortFunc(rows, func(a, b *fakeRow) int {
+		return cmp.Or(cmp.Compare(a.createdAt, b.createdAt), cmp.Compare(a.id, b.id))
+	})
+	values := make([][]driver.Value, len(rows))
+	for i, r := range rows {
+		values[i] = []driver.Value{r.id, r.request, r.attempts, r.nextAttempt, r.lastError, r.createdAt}
+	}
+	return values
+}
+
+// update applies change to the row with the given ID and dead flag, and
+// returns the number of rows affected.
+func (t *fakeTable) update(id string, dead bool, change func(*fakeRow)) int64 {
+	r := t.rows[id]
+	if r == nil || r.dead != dead {
+		return 0
+	}
+	change(r)
+	return 1
+}
+
+// run executes a statement against the table.
+func (c *fakeConn) run(query string, args []driver.NamedValue) (int64, [][]driver.Value, error) {
+	query = normalize(query)
+	if strings.HasPrefix(query, "CREATE TABLE IF NOT EXISTS ") {
+		return 0, nil, nil
+	}
+	m := tableWord.FindStringSubmatchIndex(query)
+	if m == nil {
+		return 0, nil, fmt.Errorf("fake driver: unsupported statement %q", query)
+	}
+	query = query[:m[2]] + "{table}" + query[m[3]:]
+	for stmt, exec := range fakeStatements {
+		if normalize(stmt) == query {
+			c.table.mu.Lock()
+			defer c.table.mu.Unlock()
+			return exec(c.table, args)
+		}
+	}
+	return 0, nil, fmt.Errorf("fake driver: unsupported statement %q", query)
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	n, _, err := c.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(n), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	_, rows, err := c.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) > 0 && len(r.rows[0]) == 1 {
+		return []string{"count"}
+	}
+	return []string{"id", "request", "attempts", "next_attempt", "last_error", "created_at"}
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,5 @@
+// Package sqlitetest runs the outbox store tests against SQLite. It is a
+// separate module so that the SDK does not depend on a database driver:
+//
+//	cd outbox/sqlitetest && go test ./...
+package sqlitetest
@@ -0,0 +1,30 @@
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/outbox"
+	"github.com/relaywarden/go-sdk/outbox/outboxtest"
+	_ "modernc.org/sqlite"
+)
+
+func TestSQLStore(t *testing.T) {
+	// Concurrent writers wait for the database lock instead of failing.
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "outbox.db")+"?_pragma=busy_timeout(5000)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	s, err := outbox.NewSQLStore(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateTable(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	outboxtest.TestStore(t, s)
+}
@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/models"
+)
+
+func newEntry(id string, created time.Time) *Entry {
+	return &Entry{
+		ID:          id,
+		Request:     &models.SendMessageRequest{Subject: "Message " + id, To: []models.Address{{Email: "user@example.com"}}},
+		NextAttempt: created,
+		CreatedAt:   created,
+	}
+}
+
+func TestSQLStoreDollarPlaceholders(t *testing.T) {
+	s, _ := NewSQLStore(nil, WithDollarPlaceholders())
+	got := s.query(`UPDATE {table} SET attempts = ? WHERE id = ?`)
+	if want := `UPDATE relaywarden_outbox SET attempts = $1 WHERE id = $2`; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestFileStoreRecovery(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	t0 := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if err := s.Add(ctx, newEntry(id, t0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.Complete(ctx, "a")
+	s.DeadLetter(ctx, newEntry("b", t0))
+	s.Close()
+
+	// Simulate a crash in the middle of appending a record.
+	f, err := os.OpenFile(filepath.Join(dir, walName), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"op":"put","entry":{"id":"d","req`)
+	f.Close()
+
+	s, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("Expected the store to recover, got %v", err)
+	}
+	defer s.Close()
+	due, _ := s.Due(ctx, t0, 10)
+	letters, _ := s.DeadLetters(ctx)
+	if len(due) != 1 || due[0].ID != "c" || len(letters) != 1 || letters[0].ID != "b" {
+		t.Errorf("Expected c pending and b dead-lettered after restart, got %v and %v", due, letters)
+	}
+	if err := s.Add(ctx, newEntry("d", t0)); err != nil {
+		t.Errorf("Expected the store to accept writes after recovery, got %v", err)
+	}
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	t0 := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := range 2000 {
+		e := newEntry("e", t0)
+		if err := s.Add(ctx, e); err != nil {
+			t.Fatalf("Add %d: %v", i, err)
+		}
+		if err := s.Complete(ctx, "e"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	info, err := os.Stat(filepath.Join(dir, walName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() > 512<<10 {
+		t.Errorf("Expected the log to be compacted, got %d bytes", info.Size())
+	}
+}
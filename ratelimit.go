@@ -0,0 +1,130 @@
+package relaywarden
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+// RateLimiter is a client-side token bucket that throttles requests before
+// they are sent. It also follows the rate limit state reported by the API:
+// when X-RateLimit-Remaining reaches zero, or a response carries Retry-After,
+// requests wait until the limit resets instead of being rejected with 429.
+// A client does not wait longer than its retry budget allows: a call that
+// would fails right away with an *errors.RateLimitError.
+//
+// A RateLimiter is safe for concurrent use. Set it in ClientOptions to share
+// it between all resources and scoped clients, or pass the same limiter to
+// several clients to share one budget between them.
+type RateLimiter struct {
+	mu           sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	now          func() time.Time
+}
+
+// NewRateLimiter creates a RateLimiter that allows rate requests per second
+// with bursts of up to burst requests. If rate is zero or negative, requests
+// are only throttled by the rate limit headers returned by the API.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	_, err := l.wait(ctx, -1)
+	return err
+}
+
+// wait blocks until a request may be sent and returns how long it waited.
+// If limit is not negative and the wait would exceed it, it returns an
+// *errors.RateLimitError right away instead, so a long Retry-After does not
+// hold calls beyond their retry budget.
+func (l *RateLimiter) wait(ctx context.Context, limit time.Duration) (time.Duration, error) {
+	var waited time.Duration
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return waited, ctx.Err()
+		}
+		if limit >= 0 && waited+delay > limit {
+			return waited, &errors.RateLimitError{
+				APIError: &errors.APIError{
+					Message: "Rate limited by the client: waiting would exceed the retry budget",
+					Code:    http.StatusTooManyRequests,
+				},
+				RetryAfter: int(math.Ceil(delay.Seconds())),
+			}
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return waited, err
+		}
+		waited += delay
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait
+// before trying again.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// observe updates the limiter from the rate limit headers of a response.
+func (l *RateLimiter) observe(resp *http.Response) {
+	rl := parseRateLimit(resp.Header)
+	retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	until := time.Time{}
+	switch {
+	case hasRetryAfter && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable):
+		until = now.Add(retryAfter)
+	case resp.Header.Get("X-RateLimit-Remaining") != "" && rl.Remaining <= 0 && rl.Reset.After(now):
+		until = rl.Reset
+	}
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+
+	// Never allow more requests than the server has left in this window.
+	if l.rate > 0 && resp.Header.Get("X-RateLimit-Remaining") != "" && float64(rl.Remaining) < l.tokens {
+		l.tokens = float64(rl.Remaining)
+	}
+}
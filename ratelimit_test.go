@@ -0,0 +1,184 @@
+package relaywarden
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+func TestRateLimiterTokenBucket(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	l := NewRateLimiter(10, 2)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("Expected burst request %d to pass, got wait %v", i, d)
+		}
+	}
+	if d := l.reserve(); d != 100*time.Millisecond {
+		t.Errorf("Expected 100ms wait once the burst is used, got %v", d)
+	}
+
+	now = now.Add(100 * time.Millisecond)
+	if d := l.reserve(); d != 0 {
+		t.Errorf("Expected a token after 100ms, got wait %v", d)
+	}
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	l := NewRateLimiter(0, 1)
+	l.now = func() time.Time { return now }
+
+	resp := &http.Response{StatusCode: 200, Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Limit", "100")
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(now.Add(5*time.Second).Unix(), 10))
+	l.observe(resp)
+	if d := l.reserve(); d != 5*time.Second {
+		t.Errorf("Expected to wait for the rate limit reset, got %v", d)
+	}
+
+	now = now.Add(5 * time.Second)
+	if d := l.reserve(); d != 0 {
+		t.Errorf("Expected requests to resume after reset, got wait %v", d)
+	}
+
+	resp = &http.Response{StatusCode: 429, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	l.observe(resp)
+	if d := l.reserve(); d != 3*time.Second {
+		t.Errorf("Expected to wait for Retry-After, got %v", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"120", 120 * time.Second, true},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+		{time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat), 90 * time.Second, true},
+		{"", 0, false},
+		{"-1", 0, false},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.header)
+		if ok != tt.ok || got > tt.want || got < tt.want-2*time.Second {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.header, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRateLimitErrorRetryAfterDate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", time.Now().Add(30*time.Second).UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message":"Too many requests"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{MaxRetries: 0})
+	_, err := client.Get(context.Background(), "/messages", nil)
+
+	var rateLimitErr *errors.RateLimitError
+	if !stderrors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got %v", err)
+	}
+	if rateLimitErr.RetryAfter < 29 || rateLimitErr.RetryAfter > 30 {
+		t.Errorf("Expected RetryAfter of about 30 seconds, got %d", rateLimitErr.RetryAfter)
+	}
+}
+
+func TestRateLimiterSharedByScopedClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{RateLimiter: NewRateLimiter(50, 1)})
+	scoped := client.WithProject("project-a")
+
+	start := time.Now()
+	for _, c := range []*Client{client, scoped, client, scoped} {
+		if _, err := c.Identity.GetIdentity(context.Background()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 55*time.Millisecond {
+		t.Errorf("Expected 4 requests at 50/s to take at least 60ms, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterWaitWithinBudget(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			w.Header().Set("Retry-After", "600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{RateLimiter: NewRateLimiter(0, 1)})
+	if _, err := client.Get(context.Background(), "/messages", nil); err == nil {
+		t.Fatal("Expected the 429 to be returned, got nil")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := client.Get(ctx, "/messages", nil)
+
+	var rateLimitErr *errors.RateLimitError
+	if !stderrors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got %v", err)
+	}
+	if rateLimitErr.RetryAfter < 599 {
+		t.Errorf("Expected RetryAfter of about 600 seconds, got %d", rateLimitErr.RetryAfter)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the call to fail right away, took %v", elapsed)
+	}
+	if requests != 1 {
+		t.Errorf("Expected no request to be sent while blocked, got %d", requests)
+	}
+}
+
+func TestRateLimiterWaitKeepsLastError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RateLimiter: NewRateLimiter(10, 1),
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Post(ctx, "/messages", nil, nil, option.WithIdempotencyKey("key-1"))
+
+	var serverErr *errors.ServerError
+	if !stderrors.As(err, &serverErr) || serverErr.IdempotencyKey != "key-1" {
+		t.Errorf("Expected the limiter error to keep the last attempt's error, got %v", err)
+	}
+}
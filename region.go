@@ -0,0 +1,117 @@
+package relaywarden
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Region is a regional API endpoint.
+type Region struct {
+	// Name identifies the region in ResponseInfo, logs and traces.
+	Name string
+	// BaseURL is the API base URL of the region.
+	BaseURL string
+}
+
+// defaultRegionCooldown is how long a failing region is avoided by default.
+const defaultRegionCooldown = 30 * time.Second
+
+// regionSet tracks the health of the regions a client can send requests to.
+type regionSet struct {
+	list     []Region
+	cooldown time.Duration
+
+	mu             sync.Mutex
+	unhealthyUntil []time.Time
+	now            func() time.Time
+}
+
+func newRegionSet(list []Region, cooldown time.Duration) *regionSet {
+	if cooldown <= 0 {
+		cooldown = defaultRegionCooldown
+	}
+	return &regionSet{
+		list:           list,
+		cooldown:       cooldown,
+		unhealthyUntil: make([]time.Time, len(list)),
+		now:            time.Now,
+	}
+}
+
+// pick returns the index of the region for the next attempt: the first
+// healthy region not yet tried by the call, then the first healthy region,
+// then the region that has been unhealthy the longest.
+func (s *regionSet) pick(tried []bool) int {
+	if len(s.list) == 1 {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	healthy, best := -1, 0
+	for i := range s.list {
+		if !now.Before(s.unhealthyUntil[i]) {
+			if !tried[i] {
+				return i
+			}
+			if healthy < 0 {
+				healthy = i
+			}
+		}
+		if s.unhealthyUntil[i].Before(s.unhealthyUntil[best]) {
+			best = i
+		}
+	}
+	if healthy >= 0 {
+		return healthy
+	}
+	return best
+}
+
+// untried reports whether a region other than those in tried is available
+// to fail over to.
+func (s *regionSet) untried(tried []bool) bool {
+	for i := range s.list {
+		if !tried[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// report records the health of region i after an attempt.
+func (s *regionSet) report(i int, healthy bool) {
+	if len(s.list) == 1 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if healthy {
+		s.unhealthyUntil[i] = time.Time{}
+	} else {
+		s.unhealthyUntil[i] = s.now().Add(s.cooldown)
+	}
+}
+
+// regionFailure reports whether an attempt failed in a way that suggests the
+// region is unavailable: a connection error or a 5xx response.
+func regionFailure(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	return resp.StatusCode >= 500
+}
+
+// canFailOver reports whether req may be sent to another region after a
+// failure without risking duplicate side effects.
+func canFailOver(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
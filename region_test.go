@@ -0,0 +1,121 @@
+package relaywarden
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// regionServer returns a server responding with status and counting its hits.
+func regionServer(t *testing.T, status int, hits *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRegionFailover(t *testing.T) {
+	var primaryHits, secondaryHits atomic.Int32
+	primary := regionServer(t, http.StatusServiceUnavailable, &primaryHits)
+	secondary := regionServer(t, http.StatusOK, &secondaryHits)
+
+	client := NewClient("", "test-token", ClientOptions{
+		Regions: []Region{
+			{Name: "eu-west", BaseURL: primary.URL},
+			{Name: "eu-central", BaseURL: secondary.URL},
+		},
+	})
+
+	var info option.ResponseInfo
+	if _, err := client.Domains.GetDomain(context.Background(), "dom_1", option.WithResponse(&info)); err != nil {
+		t.Fatalf("Expected the call to fail over, got %v", err)
+	}
+	if info.Region != "eu-central" || info.Attempts != 2 {
+		t.Errorf("Expected the call to be served by eu-central on attempt 2, got %q on attempt %d", info.Region, info.Attempts)
+	}
+	if info.Retries != 0 || info.Failovers != 1 {
+		t.Errorf("Expected 1 failover and no retries, got %d and %d", info.Failovers, info.Retries)
+	}
+
+	if _, err := client.WithProject("project-a").Domains.GetDomain(context.Background(), "dom_1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if primaryHits.Load() != 1 || secondaryHits.Load() != 2 {
+		t.Errorf("Expected the unhealthy region to be skipped, got %d primary and %d secondary hits",
+			primaryHits.Load(), secondaryHits.Load())
+	}
+}
+
+func TestRegionFailoverRequiresIdempotency(t *testing.T) {
+	tests := []struct {
+		name         string
+		keys         IdempotencyKeyMode
+		wantFailover bool
+	}{
+		{"idempotency key", IdempotencyKeyRandom, true},
+		{"no idempotency key", IdempotencyKeyOff, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var primaryHits, secondaryHits atomic.Int32
+			primary := regionServer(t, http.StatusInternalServerError, &primaryHits)
+			secondary := regionServer(t, http.StatusOK, &secondaryHits)
+
+			client := NewClient("", "test-token", ClientOptions{
+				IdempotencyKeys: tt.keys,
+				Regions: []Region{
+					{Name: "primary", BaseURL: primary.URL},
+					{Name: "secondary", BaseURL: secondary.URL},
+				},
+			})
+			_, err := client.Post(context.Background(), "/messages", map[string]string{"subject": "Hi"}, nil)
+			if (err == nil) != tt.wantFailover || (secondaryHits.Load() == 1) != tt.wantFailover {
+				t.Errorf("Expected failover %v, got error %v and %d secondary hits", tt.wantFailover, err, secondaryHits.Load())
+			}
+		})
+	}
+}
+
+func TestRegionFailoverOnConnectionError(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	var hits atomic.Int32
+	up := regionServer(t, http.StatusOK, &hits)
+
+	client := NewClient("", "test-token", ClientOptions{
+		Regions: []Region{{Name: "down", BaseURL: down.URL}, {Name: "up", BaseURL: up.URL}},
+	})
+	if _, err := client.Get(context.Background(), "/identity", nil); err != nil || hits.Load() != 1 {
+		t.Errorf("Expected the call to fail over after a connection error, got %v", err)
+	}
+}
+
+func TestRegionSetCooldown(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	s := newRegionSet([]Region{{Name: "a"}, {Name: "b"}}, 10*time.Second)
+	s.now = func() time.Time { return now }
+
+	s.report(0, false)
+	if got := s.pick(make([]bool, 2)); got != 1 {
+		t.Errorf("Expected the healthy region, got %d", got)
+	}
+	s.report(1, false)
+	if got := s.pick([]bool{true, true}); got != 0 {
+		t.Errorf("Expected the region unhealthy the longest when all are down, got %d", got)
+	}
+
+	now = now.Add(10 * time.Second)
+	if got := s.pick(make([]bool, 2)); got != 0 {
+		t.Errorf("Expected the primary region after its cool-down, got %d", got)
+	}
+}
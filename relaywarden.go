@@ -1,7 +1,11 @@
 package relaywarden
 
 import (
+	"context"
+
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 	"github.com/relaywarden/go-sdk/resources"
 )
 
@@ -25,7 +29,11 @@ type Client struct {
 
 // NewClient creates a new RelayWarden API client with all resources initialized.
 func NewClient(baseURL, token string, opts ...ClientOptions) *Client {
-	baseClient := newClient(baseURL, token, opts...)
+	return newScopedClient(newClient(baseURL, token, opts...))
+}
+
+// newScopedClient builds a Client with all resources bound to baseClient.
+func newScopedClient(baseClient *client) *Client {
 	var clientInterface interfaces.Client = baseClient
 
 	return &Client{
@@ -46,7 +54,26 @@ func NewClient(baseURL, token string, opts ...ClientOptions) *Client {
 	}
 }
 
-// SetProjectID sets the project ID for project-scoped operations.
+// WithProject returns a new Client scoped to projectID. The returned client
+// shares the transport, retry policy and other configuration of c; changing
+// the scope of one client does not affect the other.
+func (c *Client) WithProject(projectID string) *Client {
+	derived := c.client.derive()
+	derived.SetProjectID(projectID)
+	return newScopedClient(derived)
+}
+
+// WithTeam returns a new Client scoped to teamID. The returned client shares
+// the transport, retry policy and other configuration of c; changing the scope
+// of one client does not affect the other.
+func (c *Client) WithTeam(teamID string) *Client {
+	derived := c.client.derive()
+	derived.SetTeamID(teamID)
+	return newScopedClient(derived)
+}
+
+// SetProjectID sets the project ID for project-scoped operations. Prefer
+// WithProject when a single Client serves several projects.
 func (c *Client) SetProjectID(projectID string) {
 	c.client.SetProjectID(projectID)
 }
@@ -65,3 +92,13 @@ func (c *Client) SetTeamID(teamID string) {
 func (c *Client) GetTeamID() *string {
 	return c.client.GetTeamID()
 }
+
+// Do makes a request to an arbitrary endpoint and decodes the response envelope
+// into a Response[T], giving access to both the typed data and the response meta.
+func Do[T any](ctx context.Context, c *Client, method, path string, body interface{}, opts ...option.RequestOption) (*models.Response[T], error) {
+	var resp models.Response[T]
+	if err := c.client.Do(ctx, method, path, nil, body, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // AuditLogs handles audit log-related API operations.
@@ -17,11 +20,38 @@ func NewAuditLogs(client interfaces.Client) *AuditLogs {
 }
 
 // List returns audit logs for the current team.
-func (r *AuditLogs) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/audit-logs", filters)
+//
+// Deprecated: Use ListAuditLogs instead.
+func (r *AuditLogs) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/audit-logs", filters, operation("AuditLogs.List", opts)...)
+}
+
+// ListAuditLogs returns a page of audit logs for the current team.
+func (r *AuditLogs) ListAuditLogs(ctx context.Context, filter *models.AuditLogFilter, opts ...option.RequestOption) (*models.List[models.AuditLog], error) {
+	return list[models.AuditLog](ctx, r.client, "/audit-logs", filter.Values(), operation("AuditLogs.ListAuditLogs", opts)...)
+}
+
+// All returns an iterator over every audit log for the current team, fetching pages lazily.
+func (r *AuditLogs) All(ctx context.Context, filter *models.AuditLogFilter, opts ...option.RequestOption) iter.Seq2[models.AuditLog, error] {
+	return paginate[models.AuditLog](ctx, r.client, "/audit-logs", filter.Values(), operation("AuditLogs.All", opts)...)
 }
 
 // Get returns a specific audit log entry by ID.
-func (r *AuditLogs) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/audit-logs/"+id, nil)
+//
+// Deprecated: Use GetAuditLog instead.
+func (r *AuditLogs) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/audit-logs/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("AuditLogs.Get", opts)...)
+}
+
+// GetAuditLog returns a specific audit log entry by ID.
+func (r *AuditLogs) GetAuditLog(ctx context.Context, id string, opts ...option.RequestOption) (*models.AuditLog, error) {
+	path, err := pathf("/audit-logs/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.AuditLog](ctx, r.client, "GET", path, nil, nil, nil, operation("AuditLogs.GetAuditLog", opts)...))
 }
@@ -0,0 +1,252 @@
+package resources
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+const (
+	// defaultBatchConcurrency is the number of messages sent at once by default.
+	defaultBatchConcurrency = 10
+	// defaultBatchGracePeriod is how long messages already being sent may
+	// take to finish after the batch context is done, by default.
+	defaultBatchGracePeriod = 10 * time.Second
+)
+
+// Limiter paces requests. *relaywarden.RateLimiter implements it.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// BatchOptions configures Messages.SendBatch.
+type BatchOptions struct {
+	// Concurrency is the number of messages sent at once. Defaults to 10.
+	Concurrency int
+	// RateLimiter, if set, is waited on before each message is sent, in
+	// addition to the client's own rate limiter. Share one limiter between
+	// batches to bound their combined rate.
+	RateLimiter Limiter
+	// IdempotencyKey returns the idempotency key for the message at index i.
+	// Derive keys from the message, such as from a notification ID, so a
+	// batch can be sent again after a failure without duplicates. If nil, a
+	// random key is generated for each message. Either way, the key is
+	// reported in BatchItem.IdempotencyKey so failed messages can be sent
+	// again with the same key.
+	IdempotencyKey func(i int, req *models.SendMessageRequest) string
+	// GracePeriod is how long messages already being sent may take to finish
+	// once ctx is done, so their outcome is known. Sends still running after
+	// it are canceled. Defaults to 10 seconds.
+	GracePeriod time.Duration
+	// OnProgress, if set, is called after each message is sent or fails.
+	// Calls are serialized.
+	OnProgress func(BatchProgress)
+}
+
+// BatchProgress reports the progress of a batch after a message completes.
+type BatchProgress struct {
+	Total     int
+	Completed int
+	Failed    int
+	// Item is the result of the message that just completed.
+	Item BatchItem
+}
+
+// BatchItem is the outcome of sending one message of a batch.
+type BatchItem struct {
+	// Index is the position of the message in the batch.
+	Index int
+	// Message is the accepted message, set when Err is nil.
+	Message *models.Message
+	// Err is the error sending the message. For messages that were never
+	// attempted, it is the error that stopped the batch.
+	Err error
+	// Attempted reports whether the message was sent to the API. Messages
+	// not attempted can be sent again safely.
+	Attempted bool
+	// IdempotencyKey is the key sent with the message.
+	IdempotencyKey string
+}
+
+// BatchResult is the outcome of Messages.SendBatch.
+type BatchResult struct {
+	// Items holds the outcome of each message, in batch order.
+	Items []BatchItem
+}
+
+// Failed returns the messages that were attempted and failed.
+func (r *BatchResult) Failed() []BatchItem {
+	var failed []BatchItem
+	for _, item := range r.Items {
+		if item.Attempted && item.Err != nil {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// NotAttempted returns the messages that were never sent, because the batch
+// was canceled or the rate limiter failed before they were reached.
+func (r *BatchResult) NotAttempted() []BatchItem {
+	var skipped []BatchItem
+	for _, item := range r.Items {
+		if !item.Attempted {
+			skipped = append(skipped, item)
+		}
+	}
+	return skipped
+}
+
+// SendBatch sends messages using a bounded pool of workers and reports the
+// outcome of each one. A failed message does not stop the batch.
+//
+// When ctx is done, no new messages are started, and messages already being
+// sent are given GracePeriod to finish so their outcome is known. SendBatch
+// then returns the result along with ctx.Err(); messages never started are
+// reported by NotAttempted.
+//
+// If RateLimiter fails for a message, the message is not attempted and the
+// batch continues; SendBatch returns the result along with the first such
+// error.
+func (r *Messages) SendBatch(ctx context.Context, messages []*models.SendMessageRequest, batch BatchOptions, opts ...option.RequestOption) (*BatchResult, error) {
+	for i, req := range messages {
+		if req == nil {
+			return nil, &errors.InvalidArgumentError{Name: fmt.Sprintf("messages[%d]", i), Reason: "must not be nil"}
+		}
+	}
+
+	result := &BatchResult{Items: make([]BatchItem, len(messages))}
+	for i := range result.Items {
+		result.Items[i].Index = i
+	}
+
+	concurrency := batch.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	grace := batch.GracePeriod
+	if grace <= 0 {
+		grace = defaultBatchGracePeriod
+	}
+	opts = operation("Messages.SendBatch", opts)
+
+	var (
+		mu         sync.Mutex
+		limiterErr error
+	)
+	progress := BatchProgress{Total: len(messages)}
+	complete := func(item BatchItem) {
+		mu.Lock()
+		defer mu.Unlock()
+		result.Items[item.Index] = item
+		progress.Completed++
+		if item.Err != nil {
+			progress.Failed++
+		}
+		if batch.OnProgress != nil {
+			progress.Item = item
+			batch.OnProgress(progress)
+		}
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(messages)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				item := BatchItem{Index: i}
+				req := messages[i]
+				if batch.RateLimiter != nil {
+					if err := batch.RateLimiter.Wait(ctx); err != nil {
+						if ctx.Err() != nil {
+							continue
+						}
+						item.Err = err
+						mu.Lock()
+						if limiterErr == nil {
+							limiterErr = err
+						}
+						mu.Unlock()
+						complete(item)
+						continue
+					}
+				}
+				if batch.IdempotencyKey != nil {
+					item.IdempotencyKey = batch.IdempotencyKey(i, req)
+				} else if item.IdempotencyKey, item.Err = newBatchKey(); item.Err != nil {
+					complete(item)
+					continue
+				}
+
+				// Let messages already started finish within the grace
+				// period even if ctx is done, so their outcome is known.
+				sendCtx, cancel := withGrace(ctx, grace)
+				item.Attempted = true
+				item.Message, item.Err = r.SendMessage(sendCtx, req, item.IdempotencyKey, opts...)
+				cancel()
+				complete(item)
+			}
+		}()
+	}
+
+dispatch:
+	for i := range messages {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		for i := range result.Items {
+			if item := &result.Items[i]; !item.Attempted && item.Err == nil {
+				item.Err = err
+			}
+		}
+		return result, err
+	}
+	return result, limiterErr
+}
+
+// newBatchKey returns a random idempotency key for a batch message.
+func newBatchKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	return "batch-" + hex.EncodeToString(b[:]), nil
+}
+
+// withGrace returns a context that keeps ctx's values but is canceled grace
+// after ctx is done, rather than with it.
+func withGrace(ctx context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	graceCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, func() {
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-graceCtx.Done():
+		}
+	})
+	return graceCtx, func() {
+		stop()
+		cancel()
+	}
+}
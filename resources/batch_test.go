@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// sendClient is an interfaces.Client whose Do calls send for each message.
+type sendClient struct {
+	send func(ctx context.Context, req *models.SendMessageRequest, key string) error
+}
+
+func (c *sendClient) Do(ctx context.Context, method, path string, query url.Values, body interface{}, headers map[string]string, out interface{}, opts ...option.RequestOption) error {
+	req := body.(*models.SendMessageRequest)
+	if err := c.send(ctx, req, headers["Idempotency-Key"]); err != nil {
+		return err
+	}
+	out.(*models.Response[models.Message]).Data = models.Message{ID: "msg-" + req.Subject}
+	return nil
+}
+
+func (c *sendClient) Get(context.Context, string, map[string]string, ...option.RequestOption) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (c *sendClient) Post(context.Context, string, interface{}, map[string]string, ...option.RequestOption) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (c *sendClient) Patch(context.Context, string, interface{}, ...option.RequestOption) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (c *sendClient) Delete(context.Context, string, ...option.RequestOption) error { return nil }
+func (c *sendClient) SetProjectID(string)                                           {}
+func (c *sendClient) GetProjectID() *string                                         { return nil }
+func (c *sendClient) SetTeamID(string)                                              {}
+func (c *sendClient) GetTeamID() *string                                            { return nil }
+
+func batchMessages(n int) []*models.SendMessageRequest {
+	messages := make([]*models.SendMessageRequest, n)
+	for i := range messages {
+		messages[i] = &models.SendMessageRequest{Subject: fmt.Sprint(i)}
+	}
+	return messages
+}
+
+type countingLimiter struct{ waits atomic.Int32 }
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits.Add(1)
+	return ctx.Err()
+}
+
+func TestSendBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	client := &sendClient{send: func(ctx context.Context, req *models.SendMessageRequest, key string) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		if key != "notification-"+req.Subject {
+			t.Errorf("Expected per-message idempotency key, got %q", key)
+		}
+		if req.Subject == "3" {
+			return stderrors.New("rejected")
+		}
+		return nil
+	}}
+
+	limiter := &countingLimiter{}
+	var progressCalls int
+	var last BatchProgress
+	result, err := NewMessages(client).SendBatch(context.Background(), batchMessages(20), BatchOptions{
+		Concurrency: 4,
+		RateLimiter: limiter,
+		IdempotencyKey: func(i int, req *models.SendMessageRequest) string {
+			return "notification-" + req.Subject
+		},
+		OnProgress: func(p BatchProgress) {
+			progressCalls++
+			last = p
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if maxInFlight.Load() > 4 {
+		t.Errorf("Expected at most 4 concurrent sends, got %d", maxInFlight.Load())
+	}
+	if limiter.waits.Load() != 20 || progressCalls != 20 || last.Completed != 20 || last.Failed != 1 {
+		t.Errorf("Expected 20 waits and progress calls with 1 failure, got %d, %d, %+v", limiter.waits.Load(), progressCalls, last)
+	}
+	if failed := result.Failed(); len(failed) != 1 || failed[0].Index != 3 {
+		t.Errorf("Expected message 3 to fail, got %+v", failed)
+	}
+	if item := result.Items[5]; item.Err != nil || item.Message.ID != "msg-5" || item.IdempotencyKey != "notification-5" {
+		t.Errorf("Unexpected result for message 5: %+v", item)
+	}
+}
+
+func TestSendBatchCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var mu sync.Mutex
+	var sent int
+	client := &sendClient{send: func(sendCtx context.Context, req *models.SendMessageRequest, key string) error {
+		mu.Lock()
+		sent++
+		if sent == 5 {
+			cancel()
+		}
+		mu.Unlock()
+		return sendCtx.Err()
+	}}
+
+	result, err := NewMessages(client).SendBatch(ctx, batchMessages(50), BatchOptions{Concurrency: 1})
+	if !stderrors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if len(result.Failed()) != 0 {
+		t.Errorf("Expected messages in flight at cancellation to complete, got %+v", result.Failed())
+	}
+	skipped := result.NotAttempted()
+	if len(skipped) != 45 || skipped[0].Index != 5 || !stderrors.Is(skipped[0].Err, context.Canceled) {
+		t.Errorf("Expected messages 5-49 not to be attempted, got %d starting at %+v", len(skipped), skipped[0])
+	}
+}
+
+var errLimiter = stderrors.New("limiter closed")
+
+// failingLimiter lets allow waits through and then fails.
+type failingLimiter struct{ allow atomic.Int32 }
+
+func (l *failingLimiter) Wait(ctx context.Context) error {
+	if l.allow.Add(-1) < 0 {
+		return errLimiter
+	}
+	return nil
+}
+
+func TestSendBatchLimiterError(t *testing.T) {
+	client := &sendClient{send: func(context.Context, *models.SendMessageRequest, string) error { return nil }}
+	limiter := &failingLimiter{}
+	limiter.allow.Store(2)
+	var last BatchProgress
+	result, err := NewMessages(client).SendBatch(context.Background(), batchMessages(5), BatchOptions{
+		Concurrency: 1,
+		RateLimiter: limiter,
+		OnProgress:  func(p BatchProgress) { last = p },
+	})
+	if !stderrors.Is(err, errLimiter) {
+		t.Fatalf("Expected the limiter error, got %v", err)
+	}
+	skipped := result.NotAttempted()
+	if len(skipped) != 3 || skipped[0].Index != 2 || !stderrors.Is(skipped[0].Err, errLimiter) {
+		t.Errorf("Expected messages 2-4 not to be attempted, got %+v", skipped)
+	}
+	if last.Completed != 5 || last.Failed != 3 {
+		t.Errorf("Expected progress to report 5 completed and 3 failed, got %+v", last)
+	}
+}
+
+func TestSendBatchNilMessage(t *testing.T) {
+	messages := batchMessages(3)
+	messages[1] = nil
+	client := &sendClient{send: func(context.Context, *models.SendMessageRequest, string) error {
+		t.Error("Expected no message to be sent")
+		return nil
+	}}
+	if _, err := NewMessages(client).SendBatch(context.Background(), messages, BatchOptions{}); err == nil {
+		t.Error("Expected an error for a nil message")
+	}
+}
+
+func TestSendBatchDefaultKeys(t *testing.T) {
+	var mu sync.Mutex
+	sent := map[string]string{}
+	client := &sendClient{send: func(ctx context.Context, req *models.SendMessageRequest, key string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sent[req.Subject] = key
+		return nil
+	}}
+
+	result, err := NewMessages(client).SendBatch(context.Background(), batchMessages(5), BatchOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	keys := map[string]bool{}
+	for _, item := range result.Items {
+		if item.IdempotencyKey == "" || item.IdempotencyKey != sent[fmt.Sprint(item.Index)] {
+			t.Errorf("Expected message %d to report the key it was sent with, got %q", item.Index, item.IdempotencyKey)
+		}
+		keys[item.IdempotencyKey] = true
+	}
+	if len(keys) != 5 {
+		t.Errorf("Expected a distinct key per message, got %v", keys)
+	}
+}
+
+func TestSendBatchGracePeriod(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := &sendClient{send: func(sendCtx context.Context, req *models.SendMessageRequest, key string) error {
+		select {
+		case <-sendCtx.Done():
+			return sendCtx.Err()
+		case <-time.After(5 * time.Second):
+			return nil
+		}
+	}}
+
+	start := time.Now()
+	result, err := NewMessages(client).SendBatch(ctx, batchMessages(3), BatchOptions{Concurrency: 1, GracePeriod: 100 * time.Millisecond})
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the batch to stop after the grace period, took %v", elapsed)
+	}
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if failed := result.Failed(); len(failed) != 1 || !stderrors.Is(failed[0].Err, context.Canceled) {
+		t.Errorf("Expected the message in flight to be canceled, got %+v", failed)
+	}
+	if len(result.NotAttempted()) != 2 {
+		t.Errorf("Expected 2 messages not attempted, got %d", len(result.NotAttempted()))
+	}
+}
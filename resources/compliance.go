@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Compliance handles compliance-related API operations.
@@ -17,16 +19,37 @@ func NewCompliance(client interfaces.Client) *Compliance {
 }
 
 // GetRetention returns data retention settings for the current team.
-func (r *Compliance) GetRetention(ctx context.Context) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/compliance/retention", nil)
+//
+// Deprecated: Use GetRetentionPolicy instead.
+func (r *Compliance) GetRetention(ctx context.Context, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/compliance/retention", nil, operation("Compliance.GetRetention", opts)...)
+}
+
+// GetRetentionPolicy returns data retention settings for the current team.
+func (r *Compliance) GetRetentionPolicy(ctx context.Context, opts ...option.RequestOption) (*models.RetentionPolicy, error) {
+	return data(do[models.RetentionPolicy](ctx, r.client, "GET", "/compliance/retention", nil, nil, nil, operation("Compliance.GetRetentionPolicy", opts)...))
 }
 
 // UpdateRetention updates data retention settings.
-func (r *Compliance) UpdateRetention(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Patch(ctx, "/compliance/retention", data)
+//
+// Deprecated: Use UpdateRetentionPolicy instead.
+func (r *Compliance) UpdateRetention(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Patch(ctx, "/compliance/retention", data, operation("Compliance.UpdateRetention", opts)...)
+}
+
+// UpdateRetentionPolicy updates data retention settings.
+func (r *Compliance) UpdateRetentionPolicy(ctx context.Context, req *models.UpdateRetentionPolicyRequest, opts ...option.RequestOption) (*models.RetentionPolicy, error) {
+	return data(do[models.RetentionPolicy](ctx, r.client, "PATCH", "/compliance/retention", nil, req, nil, operation("Compliance.UpdateRetentionPolicy", opts)...))
 }
 
 // GetExportConfig returns available export formats and configuration.
-func (r *Compliance) GetExportConfig(ctx context.Context) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/compliance/exports/config", nil)
+//
+// Deprecated: Use GetExportConfiguration instead.
+func (r *Compliance) GetExportConfig(ctx context.Context, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/compliance/exports/config", nil, operation("Compliance.GetExportConfig", opts)...)
+}
+
+// GetExportConfiguration returns available export formats and configuration.
+func (r *Compliance) GetExportConfiguration(ctx context.Context, opts ...option.RequestOption) (*models.ExportConfig, error) {
+	return data(do[models.ExportConfig](ctx, r.client, "GET", "/compliance/exports/config", nil, nil, nil, operation("Compliance.GetExportConfiguration", opts)...))
 }
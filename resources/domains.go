@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Domains handles domain-related API operations.
@@ -17,51 +20,179 @@ func NewDomains(client interfaces.Client) *Domains {
 }
 
 // List returns all sending domains for the current project.
-func (r *Domains) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/domains", filters)
+//
+// Deprecated: Use ListDomains instead.
+func (r *Domains) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/domains", filters, operation("Domains.List", opts)...)
+}
+
+// ListDomains returns a page of sending domains for the current project.
+func (r *Domains) ListDomains(ctx context.Context, filter *models.DomainFilter, opts ...option.RequestOption) (*models.List[models.Domain], error) {
+	return list[models.Domain](ctx, r.client, "/domains", filter.Values(), operation("Domains.ListDomains", opts)...)
+}
+
+// All returns an iterator over every sending domain for the current project, fetching pages lazily.
+func (r *Domains) All(ctx context.Context, filter *models.DomainFilter, opts ...option.RequestOption) iter.Seq2[models.Domain, error] {
+	return paginate[models.Domain](ctx, r.client, "/domains", filter.Values(), operation("Domains.All", opts)...)
 }
 
 // Get returns a specific domain by ID.
-func (r *Domains) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/domains/"+id, nil)
+//
+// Deprecated: Use GetDomain instead.
+func (r *Domains) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Domains.Get", opts)...)
+}
+
+// GetDomain returns a specific domain by ID.
+func (r *Domains) GetDomain(ctx context.Context, id string, opts ...option.RequestOption) (*models.Domain, error) {
+	path, err := pathf("/domains/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Domain](ctx, r.client, "GET", path, nil, nil, nil, operation("Domains.GetDomain", opts)...))
 }
 
 // Create creates a new sending domain.
-func (r *Domains) Create(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/domains", data, nil)
+//
+// Deprecated: Use CreateDomain instead.
+func (r *Domains) Create(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/domains", data, nil, operation("Domains.Create", opts)...)
+}
+
+// CreateDomain creates a new sending domain.
+func (r *Domains) CreateDomain(ctx context.Context, req *models.CreateDomainRequest, opts ...option.RequestOption) (*models.Domain, error) {
+	return data(do[models.Domain](ctx, r.client, "POST", "/domains", nil, req, nil, operation("Domains.CreateDomain", opts)...))
 }
 
 // Update updates a domain.
-func (r *Domains) Update(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Patch(ctx, "/domains/"+id, data)
+//
+// Deprecated: Use UpdateDomain instead.
+func (r *Domains) Update(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Patch(ctx, path, data, operation("Domains.Update", opts)...)
+}
+
+// UpdateDomain updates a domain.
+func (r *Domains) UpdateDomain(ctx context.Context, id string, req *models.UpdateDomainRequest, opts ...option.RequestOption) (*models.Domain, error) {
+	path, err := pathf("/domains/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Domain](ctx, r.client, "PATCH", path, nil, req, nil, operation("Domains.UpdateDomain", opts)...))
 }
 
 // Delete deletes a domain.
-func (r *Domains) Delete(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/domains/"+id)
+func (r *Domains) Delete(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/domains/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("Domains.Delete", opts)...)
 }
 
 // GetDNSRecords returns DNS records required for domain verification.
-func (r *Domains) GetDNSRecords(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/domains/"+id+"/dns-records", nil)
+//
+// Deprecated: Use GetDomainDNSRecords instead.
+func (r *Domains) GetDNSRecords(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}/dns-records", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Domains.GetDNSRecords", opts)...)
+}
+
+// GetDomainDNSRecords returns DNS records required for domain verification.
+func (r *Domains) GetDomainDNSRecords(ctx context.Context, id string, opts ...option.RequestOption) ([]models.DNSRecord, error) {
+	path, err := pathf("/domains/{id}/dns-records", id)
+	if err != nil {
+		return nil, err
+	}
+	return items(do[[]models.DNSRecord](ctx, r.client, "GET", path, nil, nil, nil, operation("Domains.GetDomainDNSRecords", opts)...))
 }
 
 // GetChecks returns the current status of domain verification checks.
-func (r *Domains) GetChecks(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/domains/"+id+"/checks", nil)
+//
+// Deprecated: Use GetDomainChecks instead.
+func (r *Domains) GetChecks(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}/checks", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Domains.GetChecks", opts)...)
+}
+
+// GetDomainChecks returns the current status of domain verification checks.
+func (r *Domains) GetDomainChecks(ctx context.Context, id string, opts ...option.RequestOption) ([]models.DomainCheck, error) {
+	path, err := pathf("/domains/{id}/checks", id)
+	if err != nil {
+		return nil, err
+	}
+	return items(do[[]models.DomainCheck](ctx, r.client, "GET", path, nil, nil, nil, operation("Domains.GetDomainChecks", opts)...))
 }
 
 // Verify initiates domain verification.
-func (r *Domains) Verify(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/domains/"+id+"/verify", nil, nil)
+//
+// Deprecated: Use VerifyDomain instead.
+func (r *Domains) Verify(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}/verify", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Domains.Verify", opts)...)
+}
+
+// VerifyDomain initiates domain verification.
+func (r *Domains) VerifyDomain(ctx context.Context, id string, opts ...option.RequestOption) (*models.Domain, error) {
+	path, err := pathf("/domains/{id}/verify", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Domain](ctx, r.client, "POST", path, nil, nil, nil, operation("Domains.VerifyDomain", opts)...))
 }
 
 // RotateDKIM rotates DKIM signing keys for a domain.
-func (r *Domains) RotateDKIM(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/domains/"+id+"/dkim/rotate", nil, nil)
+//
+// Deprecated: Use RotateDomainDKIM instead.
+func (r *Domains) RotateDKIM(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}/dkim/rotate", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Domains.RotateDKIM", opts)...)
+}
+
+// RotateDomainDKIM rotates DKIM signing keys for a domain.
+func (r *Domains) RotateDomainDKIM(ctx context.Context, id string, opts ...option.RequestOption) (*models.Domain, error) {
+	path, err := pathf("/domains/{id}/dkim/rotate", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Domain](ctx, r.client, "POST", path, nil, nil, nil, operation("Domains.RotateDomainDKIM", opts)...))
 }
 
 // EnableProduction enables a domain for production use.
-func (r *Domains) EnableProduction(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/domains/"+id+"/enable-production", nil, nil)
+//
+// Deprecated: Use EnableDomainProduction instead.
+func (r *Domains) EnableProduction(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/domains/{id}/enable-production", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Domains.EnableProduction", opts)...)
+}
+
+// EnableDomainProduction enables a domain for production use.
+func (r *Domains) EnableDomainProduction(ctx context.Context, id string, opts ...option.RequestOption) (*models.Domain, error) {
+	path, err := pathf("/domains/{id}/enable-production", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Domain](ctx, r.client, "POST", path, nil, nil, nil, operation("Domains.EnableDomainProduction", opts)...))
 }
@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Events handles event-related API operations.
@@ -17,11 +20,38 @@ func NewEvents(client interfaces.Client) *Events {
 }
 
 // List returns all events for the current team.
-func (r *Events) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/events", filters)
+//
+// Deprecated: Use ListEvents instead.
+func (r *Events) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/events", filters, operation("Events.List", opts)...)
+}
+
+// ListEvents returns a page of events for the current team.
+func (r *Events) ListEvents(ctx context.Context, filter *models.EventFilter, opts ...option.RequestOption) (*models.List[models.Event], error) {
+	return list[models.Event](ctx, r.client, "/events", filter.Values(), operation("Events.ListEvents", opts)...)
+}
+
+// All returns an iterator over every event for the current team, fetching pages lazily.
+func (r *Events) All(ctx context.Context, filter *models.EventFilter, opts ...option.RequestOption) iter.Seq2[models.Event, error] {
+	return paginate[models.Event](ctx, r.client, "/events", filter.Values(), operation("Events.All", opts)...)
 }
 
 // Get returns a specific event by ID.
-func (r *Events) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/events/"+id, nil)
+//
+// Deprecated: Use GetEvent instead.
+func (r *Events) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/events/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Events.Get", opts)...)
+}
+
+// GetEvent returns a specific event by ID.
+func (r *Events) GetEvent(ctx context.Context, id string, opts ...option.RequestOption) (*models.Event, error) {
+	path, err := pathf("/events/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Event](ctx, r.client, "GET", path, nil, nil, nil, operation("Events.GetEvent", opts)...))
 }
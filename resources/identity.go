@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Identity handles identity-related API operations.
@@ -17,11 +19,25 @@ func NewIdentity(client interfaces.Client) *Identity {
 }
 
 // Me returns information about the currently authenticated user or service account.
-func (r *Identity) Me(ctx context.Context) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/me", nil)
+//
+// Deprecated: Use GetIdentity instead.
+func (r *Identity) Me(ctx context.Context, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/me", nil, operation("Identity.Me", opts)...)
+}
+
+// GetIdentity returns information about the currently authenticated user or service account.
+func (r *Identity) GetIdentity(ctx context.Context, opts ...option.RequestOption) (*models.Identity, error) {
+	return data(do[models.Identity](ctx, r.client, "GET", "/me", nil, nil, nil, operation("Identity.GetIdentity", opts)...))
 }
 
 // Teams returns all teams the authenticated user belongs to.
-func (r *Identity) Teams(ctx context.Context) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/teams", nil)
+//
+// Deprecated: Use ListTeams instead.
+func (r *Identity) Teams(ctx context.Context, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/teams", nil, operation("Identity.Teams", opts)...)
+}
+
+// ListTeams returns all teams the authenticated user belongs to.
+func (r *Identity) ListTeams(ctx context.Context, opts ...option.RequestOption) ([]models.Team, error) {
+	return items(do[[]models.Team](ctx, r.client, "GET", "/teams", nil, nil, nil, operation("Identity.ListTeams", opts)...))
 }
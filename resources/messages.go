@@ -2,8 +2,12 @@ package resources
 
 import (
 	"context"
+	"io"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Messages handles message-related API operations.
@@ -17,35 +21,133 @@ func NewMessages(client interfaces.Client) *Messages {
 }
 
 // Send sends an email message.
-func (r *Messages) Send(ctx context.Context, data map[string]interface{}, idempotencyKey string) (map[string]interface{}, error) {
+//
+// Deprecated: Use SendMessage instead.
+func (r *Messages) Send(ctx context.Context, data map[string]interface{}, idempotencyKey string, opts ...option.RequestOption) (map[string]interface{}, error) {
 	headers := make(map[string]string)
 	if idempotencyKey != "" {
 		headers["Idempotency-Key"] = idempotencyKey
 	}
-	return r.client.Post(ctx, "/messages", data, headers)
+	return r.client.Post(ctx, "/messages", data, headers, operation("Messages.Send", opts)...)
+}
+
+// SendMessage sends an email message.
+func (r *Messages) SendMessage(ctx context.Context, req *models.SendMessageRequest, idempotencyKey string, opts ...option.RequestOption) (*models.Message, error) {
+	headers := make(map[string]string)
+	if idempotencyKey != "" {
+		headers["Idempotency-Key"] = idempotencyKey
+	}
+	return data(do[models.Message](ctx, r.client, "POST", "/messages", nil, req, headers, operation("Messages.SendMessage", opts)...))
+}
+
+// SendRaw sends a pre-built RFC 5322 message, such as the content of an .eml
+// file. The message is parsed with models.ReadMessage and validated before
+// it is sent; use option.WithIdempotencyKey to set an idempotency key.
+func (r *Messages) SendRaw(ctx context.Context, raw io.Reader, opts ...option.RequestOption) (*models.Message, error) {
+	b, err := models.ReadMessage(raw)
+	if err != nil {
+		return nil, err
+	}
+	req, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Message](ctx, r.client, "POST", "/messages", nil, req, nil, operation("Messages.SendRaw", opts)...))
 }
 
 // List returns all messages for the current project.
-func (r *Messages) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/messages", filters)
+//
+// Deprecated: Use ListMessages instead.
+func (r *Messages) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/messages", filters, operation("Messages.List", opts)...)
+}
+
+// ListMessages returns a page of messages for the current project.
+func (r *Messages) ListMessages(ctx context.Context, filter *models.MessageFilter, opts ...option.RequestOption) (*models.List[models.Message], error) {
+	return list[models.Message](ctx, r.client, "/messages", filter.Values(), operation("Messages.ListMessages", opts)...)
+}
+
+// All returns an iterator over every message for the current project, fetching pages lazily.
+func (r *Messages) All(ctx context.Context, filter *models.MessageFilter, opts ...option.RequestOption) iter.Seq2[models.Message, error] {
+	return paginate[models.Message](ctx, r.client, "/messages", filter.Values(), operation("Messages.All", opts)...)
 }
 
 // Get returns a specific message by ID.
-func (r *Messages) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/messages/"+id, nil)
+//
+// Deprecated: Use GetMessage instead.
+func (r *Messages) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/messages/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Messages.Get", opts)...)
+}
+
+// GetMessage returns a specific message by ID.
+func (r *Messages) GetMessage(ctx context.Context, id string, opts ...option.RequestOption) (*models.Message, error) {
+	path, err := pathf("/messages/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Message](ctx, r.client, "GET", path, nil, nil, nil, operation("Messages.GetMessage", opts)...))
 }
 
 // GetTimeline returns the complete timeline of events for a message.
-func (r *Messages) GetTimeline(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/messages/"+id+"/timeline", nil)
+//
+// Deprecated: Use GetMessageTimeline instead.
+func (r *Messages) GetTimeline(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/messages/{id}/timeline", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Messages.GetTimeline", opts)...)
+}
+
+// GetMessageTimeline returns the complete timeline of events for a message.
+func (r *Messages) GetMessageTimeline(ctx context.Context, id string, opts ...option.RequestOption) ([]models.Event, error) {
+	path, err := pathf("/messages/{id}/timeline", id)
+	if err != nil {
+		return nil, err
+	}
+	return items(do[[]models.Event](ctx, r.client, "GET", path, nil, nil, nil, operation("Messages.GetMessageTimeline", opts)...))
 }
 
 // Cancel cancels a message that hasn't been sent yet.
-func (r *Messages) Cancel(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/messages/"+id+"/cancel", nil, nil)
+//
+// Deprecated: Use CancelMessage instead.
+func (r *Messages) Cancel(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/messages/{id}/cancel", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Messages.Cancel", opts)...)
+}
+
+// CancelMessage cancels a message that hasn't been sent yet.
+func (r *Messages) CancelMessage(ctx context.Context, id string, opts ...option.RequestOption) (*models.Message, error) {
+	path, err := pathf("/messages/{id}/cancel", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Message](ctx, r.client, "POST", path, nil, nil, nil, operation("Messages.CancelMessage", opts)...))
 }
 
 // Resend resends a previously sent message.
-func (r *Messages) Resend(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/messages/"+id+"/resend", nil, nil)
+//
+// Deprecated: Use ResendMessage instead.
+func (r *Messages) Resend(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/messages/{id}/resend", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Messages.Resend", opts)...)
+}
+
+// ResendMessage resends a previously sent message.
+func (r *Messages) ResendMessage(ctx context.Context, id string, opts ...option.RequestOption) (*models.Message, error) {
+	path, err := pathf("/messages/{id}/resend", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Message](ctx, r.client, "POST", path, nil, nil, nil, operation("Messages.ResendMessage", opts)...))
 }
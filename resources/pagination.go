@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"net/url"
+	"strconv"
+
+	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// paginate returns an iterator over every item of a list endpoint. Pages are
+// fetched lazily as the iteration proceeds, following the cursor returned in
+// the response meta when present and falling back to page numbers otherwise.
+// Iteration stops after yielding the first error, including context cancellation.
+func paginate[T any](ctx context.Context, client interfaces.Client, path string, query url.Values, opts ...option.RequestOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		values := maps.Clone(query)
+		if values == nil {
+			values = make(url.Values)
+		}
+		page := 1
+		if p, err := strconv.Atoi(values.Get("page")); err == nil && p > 0 {
+			page = p
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			cursor := values.Has("cursor")
+			if !cursor {
+				values.Set("page", strconv.Itoa(page))
+			}
+
+			resp, err := list[T](ctx, client, path, values, opts...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range resp.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if resp.Meta.NextCursor != "" {
+				values.Del("page")
+				values.Set("cursor", resp.Meta.NextCursor)
+				continue
+			}
+			if cursor || !hasNextPage(resp.Meta, len(resp.Data)) {
+				return
+			}
+			page++
+		}
+	}
+}
+
+// hasNextPage reports whether the page-number pagination meta indicates more results.
+func hasNextPage(meta models.Meta, count int) bool {
+	switch {
+	case count == 0:
+		return false
+	case meta.LastPage > 0:
+		return meta.CurrentPage < meta.LastPage
+	case meta.Total > 0 && meta.PerPage > 0:
+		return meta.CurrentPage*meta.PerPage < meta.Total
+	case meta.PerPage > 0:
+		return count >= meta.PerPage
+	default:
+		return false
+	}
+}
+
+// failed returns an iterator that yields err without fetching any page.
+func failed[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
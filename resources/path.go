@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+// pathf builds a request path from template, replacing each "{name}"
+// placeholder in turn with the matching argument escaped as a single path
+// segment. It returns an *errors.InvalidArgumentError naming the placeholder
+// if an argument is empty or a relative segment such as "..", so a bad ID
+// never reaches a different endpoint.
+func pathf(template string, args ...string) (string, error) {
+	var b strings.Builder
+	rest := template
+	for _, arg := range args {
+		start := strings.IndexByte(rest, '{')
+		end := strings.IndexByte(rest, '}')
+		if start < 0 || end < start {
+			panic("resources: too many arguments for path " + template)
+		}
+		name := rest[start+1 : end]
+		switch {
+		case strings.TrimSpace(arg) == "":
+			return "", &errors.InvalidArgumentError{Name: name, Value: arg, Reason: "must not be empty"}
+		case arg == "." || arg == "..":
+			return "", &errors.InvalidArgumentError{Name: name, Value: arg, Reason: "must not be a relative path segment"}
+		}
+		b.WriteString(rest[:start])
+		b.WriteString(url.PathEscape(arg))
+		rest = rest[end+1:]
+	}
+	if strings.IndexByte(rest, '{') >= 0 {
+		panic("resources: missing arguments for path " + template)
+	}
+	b.WriteString(rest)
+	return b.String(), nil
+}
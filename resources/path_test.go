@@ -0,0 +1,43 @@
+package resources
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+func TestPathf(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{"plain", []string{"dom_1"}, "/domains/dom_1/verify", ""},
+		{"slash", []string{"a/b"}, "/domains/a%2Fb/verify", ""},
+		{"query characters", []string{"x?y#z"}, "/domains/x%3Fy%23z/verify", ""},
+		{"space", []string{"a b"}, "/domains/a%20b/verify", ""},
+		{"empty", []string{""}, "", "id"},
+		{"blank", []string{"  "}, "", "id"},
+		{"dot dot", []string{".."}, "", "id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pathf("/domains/{id}/verify", tt.args...)
+			if tt.wantErr == "" {
+				if err != nil || got != tt.want {
+					t.Errorf("Expected %q, got %q, %v", tt.want, got, err)
+				}
+				return
+			}
+			var argErr *errors.InvalidArgumentError
+			if !stderrors.As(err, &argErr) || argErr.Name != tt.wantErr {
+				t.Fatalf("Expected InvalidArgumentError for %s, got %v", tt.wantErr, err)
+			}
+			if !stderrors.Is(err, errors.ErrInvalidArgument) {
+				t.Error("Expected the error to match ErrInvalidArgument")
+			}
+		})
+	}
+}
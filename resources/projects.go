@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Projects handles project-related API operations.
@@ -17,26 +20,79 @@ func NewProjects(client interfaces.Client) *Projects {
 }
 
 // List returns all projects for the current team.
-func (r *Projects) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/projects", filters)
+//
+// Deprecated: Use ListProjects instead.
+func (r *Projects) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/projects", filters, operation("Projects.List", opts)...)
+}
+
+// ListProjects returns a page of projects for the current team.
+func (r *Projects) ListProjects(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) (*models.List[models.Project], error) {
+	return list[models.Project](ctx, r.client, "/projects", filter.Values(), operation("Projects.ListProjects", opts)...)
+}
+
+// All returns an iterator over every project for the current team, fetching pages lazily.
+func (r *Projects) All(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) iter.Seq2[models.Project, error] {
+	return paginate[models.Project](ctx, r.client, "/projects", filter.Values(), operation("Projects.All", opts)...)
 }
 
 // Get returns a specific project by ID.
-func (r *Projects) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/projects/"+id, nil)
+//
+// Deprecated: Use GetProject instead.
+func (r *Projects) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/projects/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Projects.Get", opts)...)
+}
+
+// GetProject returns a specific project by ID.
+func (r *Projects) GetProject(ctx context.Context, id string, opts ...option.RequestOption) (*models.Project, error) {
+	path, err := pathf("/projects/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Project](ctx, r.client, "GET", path, nil, nil, nil, operation("Projects.GetProject", opts)...))
 }
 
 // Create creates a new project.
-func (r *Projects) Create(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/projects", data, nil)
+//
+// Deprecated: Use CreateProject instead.
+func (r *Projects) Create(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/projects", data, nil, operation("Projects.Create", opts)...)
+}
+
+// CreateProject creates a new project.
+func (r *Projects) CreateProject(ctx context.Context, req *models.CreateProjectRequest, opts ...option.RequestOption) (*models.Project, error) {
+	return data(do[models.Project](ctx, r.client, "POST", "/projects", nil, req, nil, operation("Projects.CreateProject", opts)...))
 }
 
 // Update updates an existing project.
-func (r *Projects) Update(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Patch(ctx, "/projects/"+id, data)
+//
+// Deprecated: Use UpdateProject instead.
+func (r *Projects) Update(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/projects/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Patch(ctx, path, data, operation("Projects.Update", opts)...)
+}
+
+// UpdateProject updates an existing project.
+func (r *Projects) UpdateProject(ctx context.Context, id string, req *models.UpdateProjectRequest, opts ...option.RequestOption) (*models.Project, error) {
+	path, err := pathf("/projects/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Project](ctx, r.client, "PATCH", path, nil, req, nil, operation("Projects.UpdateProject", opts)...))
 }
 
 // Delete deletes a project.
-func (r *Projects) Delete(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/projects/"+id)
+func (r *Projects) Delete(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/projects/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("Projects.Delete", opts)...)
 }
@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Senders handles sender-related API operations.
@@ -17,26 +20,79 @@ func NewSenders(client interfaces.Client) *Senders {
 }
 
 // List returns all sender addresses for the current project.
-func (r *Senders) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/senders", filters)
+//
+// Deprecated: Use ListSenders instead.
+func (r *Senders) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/senders", filters, operation("Senders.List", opts)...)
+}
+
+// ListSenders returns a page of sender addresses for the current project.
+func (r *Senders) ListSenders(ctx context.Context, filter *models.SenderFilter, opts ...option.RequestOption) (*models.List[models.Sender], error) {
+	return list[models.Sender](ctx, r.client, "/senders", filter.Values(), operation("Senders.ListSenders", opts)...)
+}
+
+// All returns an iterator over every sender address for the current project, fetching pages lazily.
+func (r *Senders) All(ctx context.Context, filter *models.SenderFilter, opts ...option.RequestOption) iter.Seq2[models.Sender, error] {
+	return paginate[models.Sender](ctx, r.client, "/senders", filter.Values(), operation("Senders.All", opts)...)
 }
 
 // Get returns a specific sender by ID.
-func (r *Senders) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/senders/"+id, nil)
+//
+// Deprecated: Use GetSender instead.
+func (r *Senders) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/senders/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Senders.Get", opts)...)
+}
+
+// GetSender returns a specific sender by ID.
+func (r *Senders) GetSender(ctx context.Context, id string, opts ...option.RequestOption) (*models.Sender, error) {
+	path, err := pathf("/senders/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Sender](ctx, r.client, "GET", path, nil, nil, nil, operation("Senders.GetSender", opts)...))
 }
 
 // Create creates a new sender address.
-func (r *Senders) Create(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/senders", data, nil)
+//
+// Deprecated: Use CreateSender instead.
+func (r *Senders) Create(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/senders", data, nil, operation("Senders.Create", opts)...)
+}
+
+// CreateSender creates a new sender address.
+func (r *Senders) CreateSender(ctx context.Context, req *models.CreateSenderRequest, opts ...option.RequestOption) (*models.Sender, error) {
+	return data(do[models.Sender](ctx, r.client, "POST", "/senders", nil, req, nil, operation("Senders.CreateSender", opts)...))
 }
 
 // Delete deletes a sender address.
-func (r *Senders) Delete(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/senders/"+id)
+func (r *Senders) Delete(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/senders/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("Senders.Delete", opts)...)
 }
 
 // Verify initiates sender verification.
-func (r *Senders) Verify(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/senders/"+id+"/verify", nil, nil)
+//
+// Deprecated: Use VerifySender instead.
+func (r *Senders) Verify(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/senders/{id}/verify", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Senders.Verify", opts)...)
+}
+
+// VerifySender initiates sender verification.
+func (r *Senders) VerifySender(ctx context.Context, id string, opts ...option.RequestOption) (*models.Sender, error) {
+	path, err := pathf("/senders/{id}/verify", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Sender](ctx, r.client, "POST", path, nil, nil, nil, operation("Senders.VerifySender", opts)...))
 }
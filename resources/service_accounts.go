@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // ServiceAccounts handles service account-related API operations.
@@ -17,26 +20,68 @@ func NewServiceAccounts(client interfaces.Client) *ServiceAccounts {
 }
 
 // List returns all service accounts for the current team.
-func (r *ServiceAccounts) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/service-accounts", filters)
+//
+// Deprecated: Use ListServiceAccounts instead.
+func (r *ServiceAccounts) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/service-accounts", filters, operation("ServiceAccounts.List", opts)...)
+}
+
+// ListServiceAccounts returns a page of service accounts for the current team.
+func (r *ServiceAccounts) ListServiceAccounts(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) (*models.List[models.ServiceAccount], error) {
+	return list[models.ServiceAccount](ctx, r.client, "/service-accounts", filter.Values(), operation("ServiceAccounts.ListServiceAccounts", opts)...)
+}
+
+// All returns an iterator over every service account for the current team, fetching pages lazily.
+func (r *ServiceAccounts) All(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) iter.Seq2[models.ServiceAccount, error] {
+	return paginate[models.ServiceAccount](ctx, r.client, "/service-accounts", filter.Values(), operation("ServiceAccounts.All", opts)...)
 }
 
 // Create creates a new service account.
-func (r *ServiceAccounts) Create(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/service-accounts", data, nil)
+//
+// Deprecated: Use CreateServiceAccount instead.
+func (r *ServiceAccounts) Create(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/service-accounts", data, nil, operation("ServiceAccounts.Create", opts)...)
+}
+
+// CreateServiceAccount creates a new service account.
+func (r *ServiceAccounts) CreateServiceAccount(ctx context.Context, req *models.CreateServiceAccountRequest, opts ...option.RequestOption) (*models.ServiceAccount, error) {
+	return data(do[models.ServiceAccount](ctx, r.client, "POST", "/service-accounts", nil, req, nil, operation("ServiceAccounts.CreateServiceAccount", opts)...))
 }
 
 // Delete deletes a service account.
-func (r *ServiceAccounts) Delete(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/service-accounts/"+id)
+func (r *ServiceAccounts) Delete(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/service-accounts/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("ServiceAccounts.Delete", opts)...)
 }
 
 // CreateToken creates a new API token for a service account.
-func (r *ServiceAccounts) CreateToken(ctx context.Context, serviceAccountID string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/service-accounts/"+serviceAccountID+"/tokens", data, nil)
+//
+// Deprecated: Use CreateServiceAccountToken instead.
+func (r *ServiceAccounts) CreateToken(ctx context.Context, serviceAccountID string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/service-accounts/{serviceAccountID}/tokens", serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, data, nil, operation("ServiceAccounts.CreateToken", opts)...)
+}
+
+// CreateServiceAccountToken creates a new API token for a service account.
+func (r *ServiceAccounts) CreateServiceAccountToken(ctx context.Context, serviceAccountID string, req *models.CreateTokenRequest, opts ...option.RequestOption) (*models.Token, error) {
+	path, err := pathf("/service-accounts/{serviceAccountID}/tokens", serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Token](ctx, r.client, "POST", path, nil, req, nil, operation("ServiceAccounts.CreateServiceAccountToken", opts)...))
 }
 
 // DeleteToken deletes an API token.
-func (r *ServiceAccounts) DeleteToken(ctx context.Context, tokenID string) error {
-	return r.client.Delete(ctx, "/tokens/"+tokenID)
+func (r *ServiceAccounts) DeleteToken(ctx context.Context, tokenID string, opts ...option.RequestOption) error {
+	path, err := pathf("/tokens/{tokenID}", tokenID)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("ServiceAccounts.DeleteToken", opts)...)
 }
@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Suppressions handles suppression-related API operations.
@@ -17,31 +20,61 @@ func NewSuppressions(client interfaces.Client) *Suppressions {
 }
 
 // List returns all suppressions for the current team.
-func (r *Suppressions) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/suppressions", filters)
+//
+// Deprecated: Use ListSuppressions instead.
+func (r *Suppressions) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/suppressions", filters, operation("Suppressions.List", opts)...)
+}
+
+// ListSuppressions returns a page of suppressions for the current team.
+func (r *Suppressions) ListSuppressions(ctx context.Context, filter *models.SuppressionFilter, opts ...option.RequestOption) (*models.List[models.Suppression], error) {
+	return list[models.Suppression](ctx, r.client, "/suppressions", filter.Values(), operation("Suppressions.ListSuppressions", opts)...)
+}
+
+// All returns an iterator over every suppression for the current team, fetching pages lazily.
+func (r *Suppressions) All(ctx context.Context, filter *models.SuppressionFilter, opts ...option.RequestOption) iter.Seq2[models.Suppression, error] {
+	return paginate[models.Suppression](ctx, r.client, "/suppressions", filter.Values(), operation("Suppressions.All", opts)...)
 }
 
 // Create adds a recipient to the suppression list.
-func (r *Suppressions) Create(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/suppressions", data, nil)
+//
+// Deprecated: Use CreateSuppression instead.
+func (r *Suppressions) Create(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/suppressions", data, nil, operation("Suppressions.Create", opts)...)
+}
+
+// CreateSuppression adds a recipient to the suppression list.
+func (r *Suppressions) CreateSuppression(ctx context.Context, req *models.CreateSuppressionRequest, opts ...option.RequestOption) (*models.Suppression, error) {
+	return data(do[models.Suppression](ctx, r.client, "POST", "/suppressions", nil, req, nil, operation("Suppressions.CreateSuppression", opts)...))
 }
 
 // Delete removes a recipient from the suppression list.
-func (r *Suppressions) Delete(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/suppressions/"+id)
+func (r *Suppressions) Delete(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/suppressions/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("Suppressions.Delete", opts)...)
 }
 
 // Import imports multiple suppressions in bulk.
-func (r *Suppressions) Import(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/suppressions/import", data, nil)
+//
+// Deprecated: Use ImportSuppressions instead.
+func (r *Suppressions) Import(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/suppressions/import", data, nil, operation("Suppressions.Import", opts)...)
+}
+
+// ImportSuppressions imports multiple suppressions in bulk.
+func (r *Suppressions) ImportSuppressions(ctx context.Context, req *models.ImportSuppressionsRequest, opts ...option.RequestOption) (*models.SuppressionImport, error) {
+	return data(do[models.SuppressionImport](ctx, r.client, "POST", "/suppressions/import", nil, req, nil, operation("Suppressions.ImportSuppressions", opts)...))
 }
 
 // Export exports all suppressions as a CSV file.
-func (r *Suppressions) Export(ctx context.Context) (string, error) {
+func (r *Suppressions) Export(ctx context.Context, opts ...option.RequestOption) (string, error) {
 	// Note: This endpoint returns CSV, not JSON
 	// For now, we'll return it as a string
 	// In a production SDK, you might want a separate method that returns []byte
-	_, err := r.client.Get(ctx, "/suppressions/export", nil)
+	_, err := r.client.Get(ctx, "/suppressions/export", nil, operation("Suppressions.Export", opts)...)
 	if err != nil {
 		return "", err
 	}
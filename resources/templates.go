@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Templates handles template-related API operations.
@@ -17,46 +20,168 @@ func NewTemplates(client interfaces.Client) *Templates {
 }
 
 // List returns all templates for the current project.
-func (r *Templates) List(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/templates", filters)
+//
+// Deprecated: Use ListTemplates instead.
+func (r *Templates) List(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/templates", filters, operation("Templates.List", opts)...)
+}
+
+// ListTemplates returns a page of templates for the current project.
+func (r *Templates) ListTemplates(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) (*models.List[models.Template], error) {
+	return list[models.Template](ctx, r.client, "/templates", filter.Values(), operation("Templates.ListTemplates", opts)...)
+}
+
+// All returns an iterator over every template for the current project, fetching pages lazily.
+func (r *Templates) All(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) iter.Seq2[models.Template, error] {
+	return paginate[models.Template](ctx, r.client, "/templates", filter.Values(), operation("Templates.All", opts)...)
 }
 
 // Get returns a specific template by ID.
-func (r *Templates) Get(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/templates/"+id, nil)
+//
+// Deprecated: Use GetTemplate instead.
+func (r *Templates) Get(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/templates/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, nil, operation("Templates.Get", opts)...)
+}
+
+// GetTemplate returns a specific template by ID.
+func (r *Templates) GetTemplate(ctx context.Context, id string, opts ...option.RequestOption) (*models.Template, error) {
+	path, err := pathf("/templates/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Template](ctx, r.client, "GET", path, nil, nil, nil, operation("Templates.GetTemplate", opts)...))
 }
 
 // Create creates a new template.
-func (r *Templates) Create(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/templates", data, nil)
+//
+// Deprecated: Use CreateTemplate instead.
+func (r *Templates) Create(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/templates", data, nil, operation("Templates.Create", opts)...)
+}
+
+// CreateTemplate creates a new template.
+func (r *Templates) CreateTemplate(ctx context.Context, req *models.CreateTemplateRequest, opts ...option.RequestOption) (*models.Template, error) {
+	return data(do[models.Template](ctx, r.client, "POST", "/templates", nil, req, nil, operation("Templates.CreateTemplate", opts)...))
 }
 
 // Update updates an existing template.
-func (r *Templates) Update(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Patch(ctx, "/templates/"+id, data)
+//
+// Deprecated: Use UpdateTemplate instead.
+func (r *Templates) Update(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/templates/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Patch(ctx, path, data, operation("Templates.Update", opts)...)
+}
+
+// UpdateTemplate updates an existing template.
+func (r *Templates) UpdateTemplate(ctx context.Context, id string, req *models.UpdateTemplateRequest, opts ...option.RequestOption) (*models.Template, error) {
+	path, err := pathf("/templates/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Template](ctx, r.client, "PATCH", path, nil, req, nil, operation("Templates.UpdateTemplate", opts)...))
 }
 
 // Delete deletes a template.
-func (r *Templates) Delete(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/templates/"+id)
+func (r *Templates) Delete(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/templates/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("Templates.Delete", opts)...)
 }
 
 // ListVersions returns all versions of a template.
-func (r *Templates) ListVersions(ctx context.Context, id string, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/templates/"+id+"/versions", filters)
+//
+// Deprecated: Use ListTemplateVersions instead.
+func (r *Templates) ListVersions(ctx context.Context, id string, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/templates/{id}/versions", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, filters, operation("Templates.ListVersions", opts)...)
+}
+
+// ListTemplateVersions returns a page of versions of a template.
+func (r *Templates) ListTemplateVersions(ctx context.Context, id string, filter *models.ListOptions, opts ...option.RequestOption) (*models.List[models.TemplateVersion], error) {
+	path, err := pathf("/templates/{id}/versions", id)
+	if err != nil {
+		return nil, err
+	}
+	return list[models.TemplateVersion](ctx, r.client, path, filter.Values(), operation("Templates.ListTemplateVersions", opts)...)
+}
+
+// AllVersions returns an iterator over every version of a template, fetching pages lazily.
+func (r *Templates) AllVersions(ctx context.Context, id string, filter *models.ListOptions, opts ...option.RequestOption) iter.Seq2[models.TemplateVersion, error] {
+	path, err := pathf("/templates/{id}/versions", id)
+	if err != nil {
+		return failed[models.TemplateVersion](err)
+	}
+	return paginate[models.TemplateVersion](ctx, r.client, path, filter.Values(), operation("Templates.AllVersions", opts)...)
 }
 
 // CreateVersion creates a new version of a template.
-func (r *Templates) CreateVersion(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/templates/"+id+"/versions", data, nil)
+//
+// Deprecated: Use CreateTemplateVersion instead.
+func (r *Templates) CreateVersion(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/templates/{id}/versions", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, data, nil, operation("Templates.CreateVersion", opts)...)
+}
+
+// CreateTemplateVersion creates a new version of a template.
+func (r *Templates) CreateTemplateVersion(ctx context.Context, id string, req *models.CreateTemplateVersionRequest, opts ...option.RequestOption) (*models.TemplateVersion, error) {
+	path, err := pathf("/templates/{id}/versions", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.TemplateVersion](ctx, r.client, "POST", path, nil, req, nil, operation("Templates.CreateTemplateVersion", opts)...))
 }
 
 // Render renders a template with provided data.
-func (r *Templates) Render(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/templates/"+id+"/render", data, nil)
+//
+// Deprecated: Use RenderTemplate instead.
+func (r *Templates) Render(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/templates/{id}/render", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, data, nil, operation("Templates.Render", opts)...)
+}
+
+// RenderTemplate renders a template with provided variables.
+func (r *Templates) RenderTemplate(ctx context.Context, id string, req *models.RenderTemplateRequest, opts ...option.RequestOption) (*models.RenderedTemplate, error) {
+	path, err := pathf("/templates/{id}/render", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.RenderedTemplate](ctx, r.client, "POST", path, nil, req, nil, operation("Templates.RenderTemplate", opts)...))
 }
 
 // TestSend sends a test email using the template.
-func (r *Templates) TestSend(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/templates/"+id+"/test-send", data, nil)
+//
+// Deprecated: Use TestSendTemplate instead.
+func (r *Templates) TestSend(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/templates/{id}/test-send", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, data, nil, operation("Templates.TestSend", opts)...)
+}
+
+// TestSendTemplate sends a test email using the template.
+func (r *Templates) TestSendTemplate(ctx context.Context, id string, req *models.TestSendTemplateRequest, opts ...option.RequestOption) (*models.Message, error) {
+	path, err := pathf("/templates/{id}/test-send", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Message](ctx, r.client, "POST", path, nil, req, nil, operation("Templates.TestSendTemplate", opts)...))
 }
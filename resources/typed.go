@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// do makes a request and decodes the response envelope into a Response[T].
+func do[T any](ctx context.Context, client interfaces.Client, method, path string, query url.Values, body interface{}, headers map[string]string, opts ...option.RequestOption) (*models.Response[T], error) {
+	var resp models.Response[T]
+	if err := client.Do(ctx, method, path, query, body, headers, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// list makes a GET request to a list endpoint and decodes the page of results.
+func list[T any](ctx context.Context, client interfaces.Client, path string, query url.Values, opts ...option.RequestOption) (*models.List[T], error) {
+	var page models.List[T]
+	if err := client.Do(ctx, "GET", path, query, nil, nil, &page, opts...); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// operation prepends the name of the calling resource method to opts so
+// observers can identify the call. Options passed by the caller still apply.
+func operation(name string, opts []option.RequestOption) []option.RequestOption {
+	return append([]option.RequestOption{option.WithOperation(name)}, opts...)
+}
+
+// data unwraps the payload of a typed response.
+func data[T any](resp *models.Response[T], err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// items unwraps the payload of a typed response containing a collection.
+func items[T any](resp *models.Response[[]T], err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Usage handles usage-related API operations.
@@ -17,16 +19,37 @@ func NewUsage(client interfaces.Client) *Usage {
 }
 
 // GetDaily returns daily usage statistics for the current team.
-func (r *Usage) GetDaily(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/usage/daily", filters)
+//
+// Deprecated: Use GetDailyUsage instead.
+func (r *Usage) GetDaily(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/usage/daily", filters, operation("Usage.GetDaily", opts)...)
+}
+
+// GetDailyUsage returns daily usage statistics for the current team.
+func (r *Usage) GetDailyUsage(ctx context.Context, filter *models.UsageFilter, opts ...option.RequestOption) ([]models.UsageDay, error) {
+	return items(do[[]models.UsageDay](ctx, r.client, "GET", "/usage/daily", filter.Values(), nil, nil, operation("Usage.GetDailyUsage", opts)...))
 }
 
 // GetLimits returns current usage limits and remaining quota.
-func (r *Usage) GetLimits(ctx context.Context) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/limits", nil)
+//
+// Deprecated: Use GetUsageLimits instead.
+func (r *Usage) GetLimits(ctx context.Context, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/limits", nil, operation("Usage.GetLimits", opts)...)
+}
+
+// GetUsageLimits returns current usage limits and remaining quota.
+func (r *Usage) GetUsageLimits(ctx context.Context, opts ...option.RequestOption) (*models.Limits, error) {
+	return data(do[models.Limits](ctx, r.client, "GET", "/limits", nil, nil, nil, operation("Usage.GetUsageLimits", opts)...))
 }
 
 // GetDiagnostics returns system health and diagnostic information.
-func (r *Usage) GetDiagnostics(ctx context.Context) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/diagnostics", nil)
+//
+// Deprecated: Use GetSystemDiagnostics instead.
+func (r *Usage) GetDiagnostics(ctx context.Context, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/diagnostics", nil, operation("Usage.GetDiagnostics", opts)...)
+}
+
+// GetSystemDiagnostics returns system health and diagnostic information.
+func (r *Usage) GetSystemDiagnostics(ctx context.Context, opts ...option.RequestOption) (*models.Diagnostics, error) {
+	return data(do[models.Diagnostics](ctx, r.client, "GET", "/diagnostics", nil, nil, nil, operation("Usage.GetSystemDiagnostics", opts)...))
 }
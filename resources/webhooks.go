@@ -2,8 +2,11 @@ package resources
 
 import (
 	"context"
+	"iter"
 
 	"github.com/relaywarden/go-sdk/interfaces"
+	"github.com/relaywarden/go-sdk/models"
+	"github.com/relaywarden/go-sdk/option"
 )
 
 // Webhooks handles webhook-related API operations.
@@ -17,36 +20,128 @@ func NewWebhooks(client interfaces.Client) *Webhooks {
 }
 
 // ListEndpoints returns all webhook endpoints for the current project.
-func (r *Webhooks) ListEndpoints(ctx context.Context, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/webhooks/endpoints", filters)
+//
+// Deprecated: Use ListWebhookEndpoints instead.
+func (r *Webhooks) ListEndpoints(ctx context.Context, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Get(ctx, "/webhooks/endpoints", filters, operation("Webhooks.ListEndpoints", opts)...)
+}
+
+// ListWebhookEndpoints returns a page of webhook endpoints for the current project.
+func (r *Webhooks) ListWebhookEndpoints(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) (*models.List[models.WebhookEndpoint], error) {
+	return list[models.WebhookEndpoint](ctx, r.client, "/webhooks/endpoints", filter.Values(), operation("Webhooks.ListWebhookEndpoints", opts)...)
+}
+
+// AllEndpoints returns an iterator over every webhook endpoint for the current project, fetching pages lazily.
+func (r *Webhooks) AllEndpoints(ctx context.Context, filter *models.ListOptions, opts ...option.RequestOption) iter.Seq2[models.WebhookEndpoint, error] {
+	return paginate[models.WebhookEndpoint](ctx, r.client, "/webhooks/endpoints", filter.Values(), operation("Webhooks.AllEndpoints", opts)...)
 }
 
 // CreateEndpoint creates a new webhook endpoint.
-func (r *Webhooks) CreateEndpoint(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/webhooks/endpoints", data, nil)
+//
+// Deprecated: Use CreateWebhookEndpoint instead.
+func (r *Webhooks) CreateEndpoint(ctx context.Context, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	return r.client.Post(ctx, "/webhooks/endpoints", data, nil, operation("Webhooks.CreateEndpoint", opts)...)
+}
+
+// CreateWebhookEndpoint creates a new webhook endpoint.
+func (r *Webhooks) CreateWebhookEndpoint(ctx context.Context, req *models.CreateWebhookEndpointRequest, opts ...option.RequestOption) (*models.WebhookEndpoint, error) {
+	return data(do[models.WebhookEndpoint](ctx, r.client, "POST", "/webhooks/endpoints", nil, req, nil, operation("Webhooks.CreateWebhookEndpoint", opts)...))
 }
 
 // UpdateEndpoint updates a webhook endpoint.
-func (r *Webhooks) UpdateEndpoint(ctx context.Context, id string, data map[string]interface{}) (map[string]interface{}, error) {
-	return r.client.Patch(ctx, "/webhooks/endpoints/"+id, data)
+//
+// Deprecated: Use UpdateWebhookEndpoint instead.
+func (r *Webhooks) UpdateEndpoint(ctx context.Context, id string, data map[string]interface{}, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/webhooks/endpoints/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Patch(ctx, path, data, operation("Webhooks.UpdateEndpoint", opts)...)
+}
+
+// UpdateWebhookEndpoint updates a webhook endpoint.
+func (r *Webhooks) UpdateWebhookEndpoint(ctx context.Context, id string, req *models.UpdateWebhookEndpointRequest, opts ...option.RequestOption) (*models.WebhookEndpoint, error) {
+	path, err := pathf("/webhooks/endpoints/{id}", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.WebhookEndpoint](ctx, r.client, "PATCH", path, nil, req, nil, operation("Webhooks.UpdateWebhookEndpoint", opts)...))
 }
 
 // DeleteEndpoint deletes a webhook endpoint.
-func (r *Webhooks) DeleteEndpoint(ctx context.Context, id string) error {
-	return r.client.Delete(ctx, "/webhooks/endpoints/"+id)
+func (r *Webhooks) DeleteEndpoint(ctx context.Context, id string, opts ...option.RequestOption) error {
+	path, err := pathf("/webhooks/endpoints/{id}", id)
+	if err != nil {
+		return err
+	}
+	return r.client.Delete(ctx, path, operation("Webhooks.DeleteEndpoint", opts)...)
 }
 
 // ListDeliveries returns all delivery attempts for a webhook endpoint.
-func (r *Webhooks) ListDeliveries(ctx context.Context, endpointID string, filters map[string]string) (map[string]interface{}, error) {
-	return r.client.Get(ctx, "/webhooks/endpoints/"+endpointID+"/deliveries", filters)
+//
+// Deprecated: Use ListWebhookDeliveries instead.
+func (r *Webhooks) ListDeliveries(ctx context.Context, endpointID string, filters map[string]string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/webhooks/endpoints/{endpointID}/deliveries", endpointID)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Get(ctx, path, filters, operation("Webhooks.ListDeliveries", opts)...)
+}
+
+// ListWebhookDeliveries returns a page of delivery attempts for a webhook endpoint.
+func (r *Webhooks) ListWebhookDeliveries(ctx context.Context, endpointID string, filter *models.DeliveryFilter, opts ...option.RequestOption) (*models.List[models.Delivery], error) {
+	path, err := pathf("/webhooks/endpoints/{endpointID}/deliveries", endpointID)
+	if err != nil {
+		return nil, err
+	}
+	return list[models.Delivery](ctx, r.client, path, filter.Values(), operation("Webhooks.ListWebhookDeliveries", opts)...)
+}
+
+// AllDeliveries returns an iterator over every delivery attempt for a webhook endpoint, fetching pages lazily.
+func (r *Webhooks) AllDeliveries(ctx context.Context, endpointID string, filter *models.DeliveryFilter, opts ...option.RequestOption) iter.Seq2[models.Delivery, error] {
+	path, err := pathf("/webhooks/endpoints/{endpointID}/deliveries", endpointID)
+	if err != nil {
+		return failed[models.Delivery](err)
+	}
+	return paginate[models.Delivery](ctx, r.client, path, filter.Values(), operation("Webhooks.AllDeliveries", opts)...)
 }
 
 // TestEndpoint sends a test webhook to verify the endpoint is working.
-func (r *Webhooks) TestEndpoint(ctx context.Context, id string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/webhooks/endpoints/"+id+"/test", nil, nil)
+//
+// Deprecated: Use TestWebhookEndpoint instead.
+func (r *Webhooks) TestEndpoint(ctx context.Context, id string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/webhooks/endpoints/{id}/test", id)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Webhooks.TestEndpoint", opts)...)
+}
+
+// TestWebhookEndpoint sends a test webhook to verify the endpoint is working.
+func (r *Webhooks) TestWebhookEndpoint(ctx context.Context, id string, opts ...option.RequestOption) (*models.Delivery, error) {
+	path, err := pathf("/webhooks/endpoints/{id}/test", id)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Delivery](ctx, r.client, "POST", path, nil, nil, nil, operation("Webhooks.TestWebhookEndpoint", opts)...))
 }
 
 // ReplayDelivery replays a failed webhook delivery.
-func (r *Webhooks) ReplayDelivery(ctx context.Context, deliveryID string) (map[string]interface{}, error) {
-	return r.client.Post(ctx, "/webhooks/deliveries/"+deliveryID+"/replay", nil, nil)
+//
+// Deprecated: Use ReplayWebhookDelivery instead.
+func (r *Webhooks) ReplayDelivery(ctx context.Context, deliveryID string, opts ...option.RequestOption) (map[string]interface{}, error) {
+	path, err := pathf("/webhooks/deliveries/{deliveryID}/replay", deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Post(ctx, path, nil, nil, operation("Webhooks.ReplayDelivery", opts)...)
+}
+
+// ReplayWebhookDelivery replays a failed webhook delivery.
+func (r *Webhooks) ReplayWebhookDelivery(ctx context.Context, deliveryID string, opts ...option.RequestOption) (*models.Delivery, error) {
+	path, err := pathf("/webhooks/deliveries/{deliveryID}/replay", deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	return data(do[models.Delivery](ctx, r.client, "POST", path, nil, nil, nil, operation("Webhooks.ReplayWebhookDelivery", opts)...))
 }
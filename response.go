@@ -0,0 +1,54 @@
+package relaywarden
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/relaywarden/go-sdk/option"
+)
+
+// recordResponse updates info with the metadata of an attempt's response.
+func recordResponse(info *option.ResponseInfo, resp *http.Response, body []byte) {
+	info.StatusCode = resp.StatusCode
+	info.Header = resp.Header
+	info.RateLimit = parseRateLimit(resp.Header)
+	info.RequestID = responseRequestID(resp, body)
+}
+
+// responseRequestID returns the request ID from the X-Request-Id header,
+// falling back to the meta block of the response body.
+func responseRequestID(resp *http.Response, body []byte) string {
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		return requestID
+	}
+	if len(body) == 0 {
+		return ""
+	}
+	var envelope struct {
+		Meta struct {
+			RequestID string `json:"request_id"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return ""
+	}
+	return envelope.Meta.RequestID
+}
+
+// parseRateLimit parses the X-RateLimit-* headers. The reset header may be
+// either a Unix timestamp or a number of seconds from now.
+func parseRateLimit(header http.Header) option.RateLimit {
+	var rl option.RateLimit
+	rl.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	rl.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		if reset > 1_000_000_000 {
+			rl.Reset = time.Unix(reset, 0)
+		} else {
+			rl.Reset = time.Now().Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return rl
+}
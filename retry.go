@@ -0,0 +1,126 @@
+package relaywarden
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values less than 1 are treated as 1.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry. It doubles on every
+	// subsequent retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff. Zero means no cap.
+	MaxDelay time.Duration
+	// Budget caps the total time spent waiting between attempts. Retrying
+	// stops as soon as the next wait, including one requested by the server
+	// via Retry-After, would exceed it. Zero means no budget.
+	Budget time.Duration
+	// DisableJitter turns off full jitter, making backoff delays deterministic.
+	DisableJitter bool
+	// RetryStatus reports whether a response with the given status code should
+	// be retried. Defaults to retrying 429, 502, 503 and 504.
+	RetryStatus func(statusCode int) bool
+	// RetryError reports whether a transport error should be retried. Defaults
+	// to retrying every error.
+	RetryError func(err error) bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when ClientOptions.RetryPolicy is nil.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		Budget:      30 * time.Second,
+	}
+}
+
+// maxAttempts returns the total number of attempts allowed by the policy.
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// retryStatus reports whether a response status code is retryable.
+func (p *RetryPolicy) retryStatus(statusCode int) bool {
+	if p.RetryStatus != nil {
+		return p.RetryStatus(statusCode)
+	}
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryError reports whether a transport error is retryable.
+func (p *RetryPolicy) retryError(err error) bool {
+	if p.RetryError != nil {
+		return p.RetryError(err)
+	}
+	return true
+}
+
+// backoff returns the delay before the given retry, starting at 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay > 0; i++ {
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	if delay < 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	if !p.DisableJitter && delay > 0 {
+		delay = time.Duration(rand.Int64N(int64(delay) + 1))
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header given either in delta-seconds
+// or as an HTTP date. Dates in the past yield a zero duration.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+	return max(time.Until(date), 0), true
+}
+
+// sleep waits for d to elapse or ctx to be done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
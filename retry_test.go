@@ -0,0 +1,216 @@
+package relaywarden
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/relaywarden/go-sdk/errors"
+	"github.com/relaywarden/go-sdk/option"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		BaseDelay:     100 * time.Millisecond,
+		MaxDelay:      time.Second,
+		DisableJitter: true,
+	}
+	tests := []struct {
+		retry int
+		want  time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{100, time.Second},
+	}
+	for _, tt := range tests {
+		if got := policy.backoff(tt.retry); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.retry, got, tt.want)
+		}
+	}
+
+	policy.DisableJitter = false
+	for i := 0; i < 100; i++ {
+		if got := policy.backoff(3); got < 0 || got > 400*time.Millisecond {
+			t.Fatalf("Expected jittered backoff within [0, 400ms], got %v", got)
+		}
+	}
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html>Service Unavailable</html>"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"123"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	if _, err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryNonRetryableStatus(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	if _, err := client.Get(context.Background(), "/test", nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryBudgetExceeded(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "600")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"code":"rate_limit_exceeded","message":"Rate limit exceeded"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", ClientOptions{
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, Budget: time.Second},
+	})
+	start := time.Now()
+	_, err := client.Get(context.Background(), "/test", nil)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected request to give up immediately, took %v", elapsed)
+	}
+	rateLimitErr, ok := err.(*errors.RateLimitError)
+	if !ok {
+		t.Fatalf("Expected RateLimitError, got %T", err)
+	}
+	if rateLimitErr.RetryAfter != 600 {
+		t.Errorf("Expected RetryAfter to be 600, got %d", rateLimitErr.RetryAfter)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryWaitHonorsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Post(ctx, "/test", nil, nil, option.WithIdempotencyKey("key-1"))
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	var serverErr *errors.ServerError
+	if !stderrors.As(err, &serverErr) || serverErr.IdempotencyKey != "key-1" {
+		t.Errorf("Expected the last attempt's error with its idempotency key, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected wait to stop on context deadline, took %v", elapsed)
+	}
+}
+
+// bodyRecorder is a transport that reads each request body itself before
+// forwarding it, as proxies and intercepting transports do.
+type bodyRecorder struct {
+	bodies []string
+}
+
+func (b *bodyRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	b.bodies = append(b.bodies, string(body))
+
+	forwarded := req.Clone(req.Context())
+	forwarded.Body = io.NopCloser(bytes.NewReader(body))
+	forwarded.ContentLength = int64(len(body))
+	return http.DefaultTransport.RoundTrip(forwarded)
+}
+
+func TestRetryReplaysRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(client *Client) error
+	}{
+		{"Messages.Send", func(client *Client) error {
+			_, err := client.Messages.Send(context.Background(), map[string]interface{}{
+				"subject": "Hello",
+			}, "key-1")
+			return err
+		}},
+		{"Domains.Create", func(client *Client) error {
+			_, err := client.Domains.Create(context.Background(), map[string]interface{}{
+				"name": "example.com",
+			})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, "test-token", ClientOptions{
+				RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+			})
+			recorder := &bodyRecorder{}
+			client.httpClient.Transport = recorder
+
+			if err := tt.call(client); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(recorder.bodies) != 2 {
+				t.Fatalf("Expected 2 attempts, got %d", len(recorder.bodies))
+			}
+			if recorder.bodies[0] == "" || recorder.bodies[1] != recorder.bodies[0] {
+				t.Errorf("Expected retried body to match %q, got %q", recorder.bodies[0], recorder.bodies[1])
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package relaywarden
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the API token. The client asks for a token before
+// every attempt, so a source can rotate tokens without rebuilding the client.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenRefresher is implemented by token sources that cache tokens. When the
+// API rejects a token with 401, the client calls Refresh before asking for a
+// token again, and retries the call once if the token changed.
+type TokenRefresher interface {
+	Refresh()
+}
+
+// TokenFunc adapts a function to a TokenSource. It is called before every
+// attempt, so it should cache tokens itself if fetching them is expensive.
+type TokenFunc func(ctx context.Context) (string, error)
+
+// Token calls f.
+func (f TokenFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticToken returns a TokenSource that always returns token.
+func StaticToken(token string) TokenSource {
+	return TokenFunc(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// TokenFromEnv returns a TokenSource that reads the token from the
+// environment variable name on every call.
+func TokenFromEnv(name string) TokenSource {
+	return TokenFunc(func(context.Context) (string, error) {
+		token := os.Getenv(name)
+		if token == "" {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return token, nil
+	})
+}
+
+// FileTokenSource reads the token from a file, such as a mounted Kubernetes
+// secret, and re-reads it whenever the file changes. Surrounding whitespace
+// is trimmed.
+type FileTokenSource struct {
+	path    string
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+var _ TokenRefresher = (*FileTokenSource)(nil)
+
+// TokenFromFile returns a FileTokenSource for the file at path.
+func TokenFromFile(path string) *FileTokenSource {
+	return &FileTokenSource{path: path}
+}
+
+// Token returns the token in the file, re-reading it if the file was
+// modified since the last read.
+func (s *FileTokenSource) Token(ctx context.Context) (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", s.path)
+	}
+	s.token, s.modTime, s.size = token, info.ModTime(), info.Size()
+	return s.token, nil
+}
+
+// Refresh discards the cached token so the next call to Token re-reads the
+// file even if its modification time did not change.
+func (s *FileTokenSource) Refresh() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+// currentToken returns the token for the next attempt.
+func (c *client) currentToken(ctx context.Context) (string, error) {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get API token: %w", err)
+	}
+	return token, nil
+}
+
+// tokenRotated refreshes the token source after the API rejected used, and
+// reports whether it now returns a different token.
+func (c *client) tokenRotated(ctx context.Context, used string) bool {
+	if r, ok := c.tokens.(TokenRefresher); ok {
+		r.Refresh()
+	}
+	token, err := c.tokens.Token(ctx)
+	return err == nil && token != used
+}
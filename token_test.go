@@ -0,0 +1,126 @@
+package relaywarden
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/relaywarden/go-sdk/errors"
+)
+
+// tokenServer accepts only requests authenticated with the token returned by valid.
+func tokenServer(t *testing.T, valid func() string, hits *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer "+valid() {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"Invalid token"}`))
+			return
+		}
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTokenSourceRetriesAfterRotation(t *testing.T) {
+	var hits atomic.Int32
+	server := tokenServer(t, func() string { return "new-token" }, &hits)
+
+	var calls atomic.Int32
+	source := TokenFunc(func(ctx context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			return "old-token", nil
+		}
+		return "new-token", nil
+	})
+	client := NewClient(server.URL, "", ClientOptions{TokenSource: source})
+
+	if _, err := client.Identity.GetIdentity(context.Background()); err != nil {
+		t.Fatalf("Expected the call to be retried with the rotated token, got %v", err)
+	}
+	if hits.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", hits.Load())
+	}
+}
+
+func TestTokenSourceNoRetryWithoutRotation(t *testing.T) {
+	var hits atomic.Int32
+	server := tokenServer(t, func() string { return "new-token" }, &hits)
+
+	client := NewClient(server.URL, "", ClientOptions{TokenSource: StaticToken("old-token")})
+	_, err := client.Identity.GetIdentity(context.Background())
+	if !stderrors.Is(err, errors.ErrAuthentication) {
+		t.Fatalf("Expected authentication error, got %v", err)
+	}
+	if hits.Load() != 1 {
+		t.Errorf("Expected a single request when the token did not change, got %d", hits.Load())
+	}
+}
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("token-a\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var valid atomic.Value
+	valid.Store("token-a")
+	var hits atomic.Int32
+	server := tokenServer(t, func() string { return valid.Load().(string) }, &hits)
+
+	source := TokenFromFile(path)
+	client := NewClient(server.URL, "", ClientOptions{TokenSource: source})
+	if _, err := client.Identity.GetIdentity(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Rotate the token without changing the file's size or modification time,
+	// so only the refresh after the 401 picks up the new token.
+	if err := os.WriteFile(path, []byte("token-b\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	valid.Store("token-b")
+
+	hits.Store(0)
+	if _, err := client.Identity.GetIdentity(context.Background()); err != nil {
+		t.Fatalf("Expected the call to be retried with the rotated token, got %v", err)
+	}
+	if hits.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", hits.Load())
+	}
+
+	if err := os.WriteFile(path, []byte("   \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Error("Expected an error for an empty token file")
+	}
+}
+
+func TestTokenFromEnv(t *testing.T) {
+	t.Setenv("TEST_RELAYWARDEN_TOKEN", "")
+	source := TokenFromEnv("TEST_RELAYWARDEN_TOKEN")
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Error("Expected an error when the variable is not set")
+	}
+
+	t.Setenv("TEST_RELAYWARDEN_TOKEN", "env-token")
+	if token, err := source.Token(context.Background()); err != nil || token != "env-token" {
+		t.Errorf("Expected env-token, got %q, %v", token, err)
+	}
+}